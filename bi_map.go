@@ -0,0 +1,114 @@
+package gds
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+)
+
+var ErrValueAlreadyBound = errors.New("value already bound")
+
+// BiMap is a one-to-one map with lookup in both directions. Entries keep insertion order.
+type BiMap[K, V comparable] struct {
+	forward Map[K, V]
+	inverse Map[V, K]
+}
+
+func NewBiMap[K, V comparable]() *BiMap[K, V] {
+	return &BiMap[K, V]{
+		forward: *NewMap[K, V](),
+		inverse: *NewMap[V, K](),
+	}
+}
+
+// Set binds the key and the value. Previous value of the key is unbound.
+// It returns ErrValueAlreadyBound when the value is bound to another key.
+func (m *BiMap[K, V]) Set(key K, val V) error {
+	if boundKey, has := m.inverse.Get(val); has && boundKey != key {
+		return fmt.Errorf("%w: %v is bound to %v", ErrValueAlreadyBound, val, boundKey)
+	}
+
+	if oldVal, has := m.forward.Get(key); has {
+		m.inverse.Delete(oldVal)
+	}
+
+	m.forward.Set(key, val)
+	m.inverse.Set(val, key)
+
+	return nil
+}
+
+func (m *BiMap[K, V]) GetByKey(key K) (V, bool) {
+	return m.forward.Get(key)
+}
+
+func (m *BiMap[K, V]) GetByValue(val V) (K, bool) {
+	return m.inverse.Get(val)
+}
+
+func (m *BiMap[K, V]) HasKey(key K) bool {
+	return m.forward.Has(key)
+}
+
+func (m *BiMap[K, V]) HasValue(val V) bool {
+	return m.inverse.Has(val)
+}
+
+func (m *BiMap[K, V]) DeleteByKey(key K) bool {
+	val, has := m.forward.Get(key)
+	if !has {
+		return false
+	}
+
+	m.forward.Delete(key)
+	m.inverse.Delete(val)
+
+	return true
+}
+
+func (m *BiMap[K, V]) DeleteByValue(val V) bool {
+	key, has := m.inverse.Get(val)
+	if !has {
+		return false
+	}
+
+	m.forward.Delete(key)
+	m.inverse.Delete(val)
+
+	return true
+}
+
+func (m *BiMap[K, V]) Len() int {
+	return m.forward.Len()
+}
+
+func (m *BiMap[K, V]) IsEmpty() bool {
+	return m.forward.IsEmpty()
+}
+
+func (m *BiMap[K, V]) Keys() []K {
+	return m.forward.Keys()
+}
+
+func (m *BiMap[K, V]) Values() []V {
+	return m.forward.List()
+}
+
+func (m *BiMap[K, V]) All() iter.Seq2[K, V] {
+	return m.forward.All()
+}
+
+// Inverse returns a new BiMap with swapped keys and values in the same order.
+func (m *BiMap[K, V]) Inverse() *BiMap[V, K] {
+	inverse := &BiMap[V, K]{
+		forward: *newMapWithCapacity[V, K](m.Len()),
+		inverse: *newMapWithCapacity[K, V](m.Len()),
+	}
+
+	for k, v := range m.forward.All() {
+		inverse.forward.Set(v, k)
+		inverse.inverse.Set(k, v)
+	}
+
+	return inverse
+}
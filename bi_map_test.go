@@ -0,0 +1,120 @@
+package gds
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBiMap_Get(t *testing.T) {
+	m := NewBiMap[string, string]()
+
+	require.NoError(t, m.Set("ID", "id"))
+	require.NoError(t, m.Set("UserName", "user_name"))
+	require.NoError(t, m.Set("CreatedAt", "created_at"))
+
+	column, ok := m.GetByKey("UserName")
+	require.True(t, ok)
+	assert.Equal(t, "user_name", column)
+
+	field, ok := m.GetByValue("created_at")
+	require.True(t, ok)
+	assert.Equal(t, "CreatedAt", field)
+
+	_, ok = m.GetByValue("UserName")
+	assert.False(t, ok)
+
+	assert.Equal(t, []string{"ID", "UserName", "CreatedAt"}, m.Keys())
+	assert.Equal(t, []string{"id", "user_name", "created_at"}, m.Values())
+}
+
+func TestBiMap_Set(t *testing.T) {
+	cases := []struct {
+		Title string
+		Check func(t *testing.T, m *BiMap[string, string])
+	}{
+		{
+			Title: "value already bound",
+			Check: func(t *testing.T, m *BiMap[string, string]) {
+				err := m.Set("Name", "user_name")
+				require.ErrorIs(t, err, ErrValueAlreadyBound)
+
+				assert.False(t, m.HasKey("Name"))
+				assert.Equal(t, 3, m.Len())
+			},
+		},
+		{
+			Title: "same binding",
+			Check: func(t *testing.T, m *BiMap[string, string]) {
+				require.NoError(t, m.Set("ID", "id"))
+				assert.Equal(t, 3, m.Len())
+			},
+		},
+		{
+			Title: "rebind key",
+			Check: func(t *testing.T, m *BiMap[string, string]) {
+				require.NoError(t, m.Set("UserName", "login"))
+
+				assert.False(t, m.HasValue("user_name"))
+
+				field, ok := m.GetByValue("login")
+				require.True(t, ok)
+				assert.Equal(t, "UserName", field)
+				assert.Equal(t, []string{"id", "login", "created_at"}, m.Values())
+
+				require.NoError(t, m.Set("Name", "user_name"))
+			},
+		},
+	}
+
+	for _, tCase := range cases {
+		t.Run(tCase.Title, func(t *testing.T) {
+			m := NewBiMap[string, string]()
+
+			require.NoError(t, m.Set("ID", "id"))
+			require.NoError(t, m.Set("UserName", "user_name"))
+			require.NoError(t, m.Set("CreatedAt", "created_at"))
+
+			tCase.Check(t, m)
+		})
+	}
+}
+
+func TestBiMap_Delete(t *testing.T) {
+	m := NewBiMap[string, string]()
+
+	require.NoError(t, m.Set("ID", "id"))
+	require.NoError(t, m.Set("UserName", "user_name"))
+	require.NoError(t, m.Set("CreatedAt", "created_at"))
+
+	assert.True(t, m.DeleteByKey("ID"))
+	assert.False(t, m.DeleteByKey("ID"))
+	assert.False(t, m.HasValue("id"))
+
+	assert.True(t, m.DeleteByValue("user_name"))
+	assert.False(t, m.DeleteByValue("user_name"))
+	assert.False(t, m.HasKey("UserName"))
+
+	assert.Equal(t, []string{"CreatedAt"}, m.Keys())
+	require.NoError(t, m.Set("ID2", "id"))
+}
+
+func TestBiMap_Inverse(t *testing.T) {
+	m := NewBiMap[string, string]()
+
+	require.NoError(t, m.Set("ID", "id"))
+	require.NoError(t, m.Set("UserName", "user_name"))
+	require.NoError(t, m.Set("CreatedAt", "created_at"))
+
+	inverse := m.Inverse()
+
+	assert.Equal(t, []string{"id", "user_name", "created_at"}, inverse.Keys())
+
+	field, ok := inverse.GetByKey("user_name")
+	require.True(t, ok)
+	assert.Equal(t, "UserName", field)
+
+	require.NoError(t, inverse.Set("email", "Email"))
+	assert.False(t, m.HasKey("Email"))
+}
@@ -1,6 +1,6 @@
 package gds
 
-type Collection[V comparable] interface {
+type Collection[V any] interface {
 	First() V
 	Len() int
 	IsEmpty() bool
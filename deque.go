@@ -0,0 +1,171 @@
+package gds
+
+import "iter"
+
+const dequeMinCapacity = 8
+
+// Deque is a double-ended queue on a growable ring buffer.
+// Pushes and pops at both ends are amortized O(1). The zero value is an empty deque ready to use.
+type Deque[T any] struct {
+	buf  []T
+	head int
+	size int
+
+	nilVal T
+}
+
+var _ Collection[int] = (*Deque[int])(nil)
+
+func NewDeque[T any](values ...T) *Deque[T] {
+	d := &Deque[T]{}
+
+	for _, value := range values {
+		d.PushBack(value)
+	}
+
+	return d
+}
+
+func (d *Deque[T]) PushBack(val T) {
+	d.growIfFull()
+
+	d.buf[d.index(d.size)] = val
+	d.size++
+}
+
+func (d *Deque[T]) PushFront(val T) {
+	d.growIfFull()
+
+	d.head = d.index(len(d.buf) - 1)
+	d.buf[d.head] = val
+	d.size++
+}
+
+func (d *Deque[T]) PopBack() (T, bool) {
+	if d.size == 0 {
+		return d.nilVal, false
+	}
+
+	i := d.index(d.size - 1)
+	val := d.buf[i]
+
+	d.buf[i] = d.nilVal
+	d.size--
+
+	return val, true
+}
+
+func (d *Deque[T]) PopFront() (T, bool) {
+	if d.size == 0 {
+		return d.nilVal, false
+	}
+
+	val := d.buf[d.head]
+
+	d.buf[d.head] = d.nilVal
+	d.head = d.index(1)
+	d.size--
+
+	return val, true
+}
+
+func (d *Deque[T]) PeekFront() (T, bool) {
+	if d.size == 0 {
+		return d.nilVal, false
+	}
+
+	return d.buf[d.head], true
+}
+
+func (d *Deque[T]) PeekBack() (T, bool) {
+	if d.size == 0 {
+		return d.nilVal, false
+	}
+
+	return d.buf[d.index(d.size-1)], true
+}
+
+// At returns the item at position i from the front, or the zero value when i is out of range.
+func (d *Deque[T]) At(i int) T {
+	if i < 0 || i >= d.size {
+		return d.nilVal
+	}
+
+	return d.buf[d.index(i)]
+}
+
+func (d *Deque[T]) First() T {
+	val, _ := d.PeekFront()
+
+	return val
+}
+
+func (d *Deque[T]) Len() int {
+	return d.size
+}
+
+func (d *Deque[T]) IsEmpty() bool {
+	return d.size == 0
+}
+
+func (d *Deque[T]) IsNotEmpty() bool {
+	return d.size > 0
+}
+
+// List returns items from the front to the back.
+func (d *Deque[T]) List() []T {
+	list := make([]T, d.size)
+	for i := range list {
+		list[i] = d.buf[d.index(i)]
+	}
+
+	return list
+}
+
+func (d *Deque[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < d.size; i++ {
+			if !yield(d.buf[d.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+func (d *Deque[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := d.size - 1; i >= 0; i-- {
+			if i >= d.size {
+				continue
+			}
+
+			if !yield(d.buf[d.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+func (d *Deque[T]) Clear() {
+	clear(d.buf)
+
+	d.head = 0
+	d.size = 0
+}
+
+func (d *Deque[T]) index(offset int) int {
+	return (d.head + offset) % len(d.buf)
+}
+
+func (d *Deque[T]) growIfFull() {
+	if d.size < len(d.buf) {
+		return
+	}
+
+	buf := make([]T, max(len(d.buf)*2, dequeMinCapacity)) //nolint:mnd // double capacity
+	n := copy(buf, d.buf[d.head:])
+	copy(buf[n:], d.buf[:d.head])
+
+	d.buf = buf
+	d.head = 0
+}
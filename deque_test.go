@@ -0,0 +1,119 @@
+package gds
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeque_PushPop(t *testing.T) {
+	d := NewDeque[int](2, 3)
+
+	d.PushFront(1)
+	d.PushBack(4)
+
+	assert.Equal(t, []int{1, 2, 3, 4}, d.List())
+	assert.Equal(t, 1, d.First())
+
+	front, ok := d.PeekFront()
+	require.True(t, ok)
+	assert.Equal(t, 1, front)
+
+	back, ok := d.PeekBack()
+	require.True(t, ok)
+	assert.Equal(t, 4, back)
+
+	val, ok := d.PopFront()
+	require.True(t, ok)
+	assert.Equal(t, 1, val)
+
+	val, ok = d.PopBack()
+	require.True(t, ok)
+	assert.Equal(t, 4, val)
+
+	assert.Equal(t, []int{2, 3}, d.List())
+	assert.Equal(t, 3, d.At(1))
+	assert.Equal(t, 0, d.At(2))
+}
+
+func TestDeque_Empty(t *testing.T) {
+	var d Deque[string]
+
+	_, ok := d.PopFront()
+	assert.False(t, ok)
+
+	_, ok = d.PopBack()
+	assert.False(t, ok)
+
+	_, ok = d.PeekFront()
+	assert.False(t, ok)
+
+	_, ok = d.PeekBack()
+	assert.False(t, ok)
+
+	assert.Equal(t, "", d.First())
+	assert.True(t, d.IsEmpty())
+	assert.False(t, d.IsNotEmpty())
+	assert.Equal(t, []string{}, d.List())
+
+	d.PushFront("a")
+	assert.Equal(t, []string{"a"}, d.List())
+}
+
+func TestDeque_WrapAroundAndGrow(t *testing.T) {
+	d := NewDeque[int]()
+	expected := []int{}
+
+	for i := 0; i < 1000; i++ {
+		switch i % 5 {
+		case 0, 1:
+			d.PushBack(i)
+			expected = append(expected, i)
+		case 2:
+			d.PushFront(i)
+			expected = slices.Insert(expected, 0, i)
+		case 3:
+			val, ok := d.PopFront()
+			require.True(t, ok)
+			assert.Equal(t, expected[0], val)
+			expected = expected[1:]
+		case 4:
+			if i%3 == 0 {
+				val, ok := d.PopBack()
+				require.True(t, ok)
+				assert.Equal(t, expected[len(expected)-1], val)
+				expected = expected[:len(expected)-1]
+			}
+		}
+
+		require.Equal(t, len(expected), d.Len())
+	}
+
+	assert.Equal(t, expected, d.List())
+	assert.Equal(t, expected, slices.Collect(d.All()))
+
+	reversed := slices.Clone(expected)
+	slices.Reverse(reversed)
+	assert.Equal(t, reversed, slices.Collect(d.Backward()))
+
+	d.Clear()
+	assert.True(t, d.IsEmpty())
+}
+
+func BenchmarkDeque_PushPop(b *testing.B) {
+	d := NewDeque[int]()
+
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		d.PushBack(i)
+		d.PushFront(i)
+
+		if i%2 == 0 {
+			d.PopFront()
+			d.PopBack()
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package gds
+
+// MapValues returns a map with the same keys and values converted by mapper.
+func MapValues[K comparable, V, R any](m *Map[K, V], mapper func(key K, val V) R) *Map[K, R] {
+	result := newMapWithCapacity[K, R](m.Len())
+
+	for k, v := range m.All() {
+		result.Set(k, mapper(k, v))
+	}
+
+	return result
+}
+
+func FilterMap[K comparable, V any](m *Map[K, V], predicate func(key K, val V) bool) *Map[K, V] {
+	result := NewMap[K, V]()
+
+	for k, v := range m.All() {
+		if predicate(k, v) {
+			result.Set(k, v)
+		}
+	}
+
+	return result
+}
+
+func ReduceMap[K comparable, V, R any](m *Map[K, V], initial R, reducer func(acc R, key K, val V) R) R {
+	acc := initial
+
+	for k, v := range m.All() {
+		acc = reducer(acc, k, v)
+	}
+
+	return acc
+}
+
+// MapSet returns a set of converted items. Items which become equal after converting are kept once.
+func MapSet[T, R comparable](s *Set[T], mapper func(item T) R) *Set[R] {
+	result := &Set[R]{
+		items: *newMapWithCapacity[R, struct{}](s.Len()),
+	}
+
+	for item := range s.All() {
+		result.Add(mapper(item))
+	}
+
+	return result
+}
+
+func FilterSet[T comparable](s *Set[T], predicate func(item T) bool) *Set[T] {
+	result := NewSet[T]()
+
+	for item := range s.All() {
+		if predicate(item) {
+			result.Add(item)
+		}
+	}
+
+	return result
+}
+
+// PartitionSet splits items into matched and rest by predicate.
+func PartitionSet[T comparable](s *Set[T], predicate func(item T) bool) (matched, rest *Set[T]) {
+	matched = NewSet[T]()
+	rest = NewSet[T]()
+
+	for item := range s.All() {
+		if predicate(item) {
+			matched.Add(item)
+		} else {
+			rest.Add(item)
+		}
+	}
+
+	return matched, rest
+}
+
+// GroupBy groups items by key. Groups are ordered by first occurrence of the key.
+func GroupBy[T, K comparable](coll Collection[T], key func(item T) K) *Map[K, *Set[T]] {
+	groups := NewMap[K, *Set[T]]()
+
+	for _, item := range coll.List() {
+		k := key(item)
+
+		group, has := groups.Get(k)
+		if !has {
+			group = NewSet[T]()
+			groups.Set(k, group)
+		}
+
+		group.Add(item)
+	}
+
+	return groups
+}
+
+// KeyBy indexes items by key. When keys collide, the last item wins and the key keeps its first position.
+func KeyBy[T, K comparable](coll Collection[T], key func(item T) K) *Map[K, T] {
+	return KeyBySlice(coll.List(), key)
+}
+
+// KeyBySlice indexes items of the slice by key. When keys collide, the last item wins
+// and the key keeps its first position.
+func KeyBySlice[T any, K comparable](items []T, key func(item T) K) *Map[K, T] {
+	result := newMapWithCapacity[K, T](len(items))
+
+	for _, item := range items {
+		result.Set(key(item), item)
+	}
+
+	return result
+}
+
+// Associate builds a map from entries returned by transform, in the order of items.
+// When keys collide, the last value wins and the key keeps its first position.
+func Associate[T any, K comparable, V any](items []T, transform func(item T) (K, V)) *Map[K, V] {
+	result := newMapWithCapacity[K, V](len(items))
+
+	for _, item := range items {
+		result.Set(transform(item))
+	}
+
+	return result
+}
@@ -0,0 +1,150 @@
+package gds
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapValues(t *testing.T) {
+	m := NewMap[string, int]()
+	m.Set("b", 1)
+	m.Set("a", 2)
+
+	got := MapValues(m, func(key string, val int) string {
+		return key + strconv.Itoa(val)
+	})
+
+	assert.Equal(t, []string{"b", "a"}, got.Keys())
+	assert.Equal(t, []string{"b1", "a2"}, got.List())
+}
+
+func TestFilterMap(t *testing.T) {
+	m := NewMap[string, int]()
+	m.Set("c", 3)
+	m.Set("a", 2)
+	m.Set("b", 1)
+
+	got := FilterMap(m, func(_ string, val int) bool {
+		return val != 2
+	})
+
+	assert.Equal(t, []string{"c", "b"}, got.Keys())
+	assert.Equal(t, 3, m.Len())
+}
+
+func TestReduceMap(t *testing.T) {
+	m := NewMap[string, int]()
+	m.Set("c", 3)
+	m.Set("a", 2)
+
+	got := ReduceMap(m, "", func(acc string, key string, val int) string {
+		return acc + key + strconv.Itoa(val)
+	})
+
+	assert.Equal(t, "c3a2", got)
+}
+
+func TestMapSet(t *testing.T) {
+	set := NewSet[string]("b", "A", "a", "c")
+
+	got := MapSet(set, strings.ToUpper)
+
+	assert.Equal(t, []string{"B", "A", "C"}, got.List())
+}
+
+func TestFilterSet(t *testing.T) {
+	set := NewSet[int](5, 2, 4, 1)
+
+	got := FilterSet(set, func(item int) bool {
+		return item%2 == 0
+	})
+
+	assert.Equal(t, []int{2, 4}, got.List())
+}
+
+func TestPartitionSet(t *testing.T) {
+	set := NewSet[int](5, 2, 4, 1)
+
+	even, odd := PartitionSet(set, func(item int) bool {
+		return item%2 == 0
+	})
+
+	assert.Equal(t, []int{2, 4}, even.List())
+	assert.Equal(t, []int{5, 1}, odd.List())
+}
+
+func TestGroupBy(t *testing.T) {
+	strs := NewStrings("user_id", "name", "order_id", "email", "user_id")
+
+	got := GroupBy[string](strs, func(item string) bool {
+		return strings.HasSuffix(item, "_id")
+	})
+
+	assert.Equal(t, []bool{true, false}, got.Keys())
+
+	ids, _ := got.Get(true)
+	assert.Equal(t, []string{"user_id", "order_id"}, ids.List())
+
+	other, _ := got.Get(false)
+	assert.Equal(t, []string{"name", "email"}, other.List())
+}
+
+func TestKeyBy(t *testing.T) {
+	type column struct {
+		Name string
+		Type string
+	}
+
+	set := NewSet[column](
+		column{Name: "id", Type: "int"},
+		column{Name: "name", Type: "text"},
+		column{Name: "id", Type: "bigint"},
+	)
+
+	got := KeyBy[column](set, func(item column) string {
+		return item.Name
+	})
+
+	assert.Equal(t, []string{"id", "name"}, got.Keys())
+	assert.Equal(t, []column{
+		{Name: "id", Type: "bigint"},
+		{Name: "name", Type: "text"},
+	}, got.List())
+}
+
+func TestKeyBySlice(t *testing.T) {
+	type column struct {
+		Name string
+		Type string
+	}
+
+	columns := []column{
+		{Name: "id", Type: "int"},
+		{Name: "name", Type: "text"},
+		{Name: "id", Type: "bigint"},
+	}
+
+	got := KeyBySlice(columns, func(item column) string {
+		return item.Name
+	})
+
+	assert.Equal(t, []string{"id", "name"}, got.Keys())
+	assert.Equal(t, []column{
+		{Name: "id", Type: "bigint"},
+		{Name: "name", Type: "text"},
+	}, got.List())
+}
+
+func TestAssociate(t *testing.T) {
+	got := Associate([]string{"user_id", "name", "user_id"}, func(item string) (string, *String) {
+		return item, NewString(item).Pascal()
+	})
+
+	assert.Equal(t, []string{"user_id", "name"}, got.Keys())
+
+	name, _ := got.Get("name")
+	assert.Equal(t, "Name", name.Value)
+}
@@ -0,0 +1,340 @@
+package gds
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var ErrGraphCycle = errors.New("graph has a cycle")
+
+// Graph is a directed graph. Nodes and edges keep insertion order, so all results are deterministic.
+// The zero value is an empty graph ready to use.
+type Graph[T comparable] struct {
+	edges Map[T, *Set[T]]
+}
+
+func NewGraph[T comparable]() *Graph[T] {
+	return &Graph[T]{
+		edges: *NewMap[T, *Set[T]](),
+	}
+}
+
+// AddNode adds the node if it is not present.
+func (g *Graph[T]) AddNode(node T) {
+	if g.edges.Has(node) {
+		return
+	}
+
+	g.edges.Set(node, NewSet[T]())
+}
+
+// AddEdge adds an edge from -> to, missing nodes are added.
+// TopoSort places from before to.
+func (g *Graph[T]) AddEdge(from, to T) {
+	g.AddNode(from)
+	g.AddNode(to)
+
+	g.successors(from).Add(to)
+}
+
+func (g *Graph[T]) RemoveEdge(from, to T) bool {
+	if !g.edges.Has(from) {
+		return false
+	}
+
+	return g.successors(from).Remove(to)
+}
+
+// RemoveNode removes the node with its incoming and outgoing edges.
+func (g *Graph[T]) RemoveNode(node T) bool {
+	if !g.edges.Has(node) {
+		return false
+	}
+
+	g.edges.Delete(node)
+
+	for _, successors := range g.edges.All() {
+		successors.Remove(node)
+	}
+
+	return true
+}
+
+func (g *Graph[T]) HasNode(node T) bool {
+	return g.edges.Has(node)
+}
+
+func (g *Graph[T]) HasEdge(from, to T) bool {
+	successors, ok := g.edges.Get(from)
+
+	return ok && successors.Has(to)
+}
+
+// Nodes returns nodes in insertion order.
+func (g *Graph[T]) Nodes() []T {
+	return g.edges.Keys()
+}
+
+// Successors returns targets of node edges in insertion order.
+func (g *Graph[T]) Successors(node T) []T {
+	successors, ok := g.edges.Get(node)
+	if !ok {
+		return []T{}
+	}
+
+	return successors.List()
+}
+
+func (g *Graph[T]) Len() int {
+	return g.edges.Len()
+}
+
+func (g *Graph[T]) IsEmpty() bool {
+	return g.edges.IsEmpty()
+}
+
+// TopoSort returns nodes where every node goes before its successors.
+// Independent nodes keep insertion order. It returns ErrGraphCycle with the cycle path when graph is not acyclic.
+func (g *Graph[T]) TopoSort() ([]T, error) {
+	inDegree := newMapWithCapacity[T, int](g.Len())
+	for node := range g.edges.KeysSeq() {
+		inDegree.Set(node, 0)
+	}
+
+	for _, successors := range g.edges.All() {
+		for to := range successors.All() {
+			degree, _ := inDegree.Get(to)
+			inDegree.Set(to, degree+1)
+		}
+	}
+
+	ready := NewQueue[T]()
+	for node, degree := range inDegree.All() {
+		if degree == 0 {
+			ready.Push(node)
+		}
+	}
+
+	sorted := make([]T, 0, g.Len())
+
+	for node, ok := ready.Pop(); ok; node, ok = ready.Pop() {
+		sorted = append(sorted, node)
+		inDegree.Delete(node)
+
+		for to := range g.successors(node).All() {
+			degree, _ := inDegree.Get(to)
+			if degree == 1 {
+				ready.Push(to)
+			}
+
+			inDegree.Set(to, degree-1)
+		}
+	}
+
+	if inDegree.IsNotEmpty() {
+		return nil, fmt.Errorf("%w: %s", ErrGraphCycle, g.formatPath(g.findCycle(inDegree)))
+	}
+
+	return sorted, nil
+}
+
+// StronglyConnectedComponents returns groups of nodes reachable from each other.
+// Components go in reverse topological order: a component goes after all components reachable from it.
+func (g *Graph[T]) StronglyConnectedComponents() []*Set[T] {
+	tarjan := &graphTarjan[T]{
+		graph:   g,
+		indexes: newMapWithCapacity[T, int](g.Len()),
+		lowLink: newMapWithCapacity[T, int](g.Len()),
+		stack:   NewStack[T](),
+		onStack: NewSet[T](),
+	}
+
+	for node := range g.edges.KeysSeq() {
+		if !tarjan.indexes.Has(node) {
+			tarjan.connect(node)
+		}
+	}
+
+	return tarjan.components
+}
+
+// Reachable returns nodes reachable from the node by at least one edge in breadth-first order.
+// The node itself is included only when it lies on a cycle.
+func (g *Graph[T]) Reachable(from T) *Set[T] {
+	reachable := NewSet[T]()
+	if !g.edges.Has(from) {
+		return reachable
+	}
+
+	queue := NewQueue(from)
+
+	for node, ok := queue.Pop(); ok; node, ok = queue.Pop() {
+		for to := range g.successors(node).All() {
+			if reachable.Has(to) {
+				continue
+			}
+
+			reachable.Add(to)
+			queue.Push(to)
+		}
+	}
+
+	return reachable
+}
+
+// DOT returns graph in Graphviz DOT format.
+func (g *Graph[T]) DOT() string {
+	var b strings.Builder
+
+	b.WriteString("digraph {\n")
+
+	for node := range g.edges.KeysSeq() {
+		fmt.Fprintf(&b, "\t%s;\n", dotID(node))
+	}
+
+	for from, successors := range g.edges.All() {
+		for to := range successors.All() {
+			fmt.Fprintf(&b, "\t%s -> %s;\n", dotID(from), dotID(to))
+		}
+	}
+
+	b.WriteString("}\n")
+
+	return b.String()
+}
+
+func (g *Graph[T]) successors(node T) *Set[T] {
+	successors, _ := g.edges.Get(node)
+
+	return successors
+}
+
+// findCycle returns a closed path, e.g. [a b a], among nodes which were not sorted.
+func (g *Graph[T]) findCycle(remaining *Map[T, int]) []T {
+	visited := NewSet[T]()
+	path := NewSet[T]()
+
+	var visit func(node T) []T
+	visit = func(node T) []T {
+		visited.Add(node)
+		path.Add(node)
+
+		for to := range g.successors(node).All() {
+			if !remaining.Has(to) {
+				continue
+			}
+
+			if path.Has(to) {
+				cycle := []T{}
+				for _, item := range path.List() {
+					if len(cycle) > 0 || item == to {
+						cycle = append(cycle, item)
+					}
+				}
+
+				return append(cycle, to)
+			}
+
+			if !visited.Has(to) {
+				if cycle := visit(to); cycle != nil {
+					return cycle
+				}
+			}
+		}
+
+		path.Remove(node)
+
+		return nil
+	}
+
+	for node := range remaining.KeysSeq() {
+		if visited.Has(node) {
+			continue
+		}
+
+		if cycle := visit(node); cycle != nil {
+			return cycle
+		}
+	}
+
+	return nil
+}
+
+func (g *Graph[T]) formatPath(path []T) string {
+	nodes := make([]string, 0, len(path))
+	for _, node := range path {
+		nodes = append(nodes, fmt.Sprint(node))
+	}
+
+	return strings.Join(nodes, " -> ")
+}
+
+type graphTarjan[T comparable] struct {
+	graph      *Graph[T]
+	index      int
+	indexes    *Map[T, int]
+	lowLink    *Map[T, int]
+	stack      *Stack[T]
+	onStack    *Set[T]
+	components []*Set[T]
+}
+
+func (t *graphTarjan[T]) connect(node T) {
+	t.indexes.Set(node, t.index)
+	t.lowLink.Set(node, t.index)
+	t.index++
+
+	t.stack.Push(node)
+	t.onStack.Add(node)
+
+	for to := range t.graph.successors(node).All() {
+		if !t.indexes.Has(to) {
+			t.connect(to)
+
+			toLow, _ := t.lowLink.Get(to)
+			t.lowerLink(node, toLow)
+		} else if t.onStack.Has(to) {
+			toIndex, _ := t.indexes.Get(to)
+			t.lowerLink(node, toIndex)
+		}
+	}
+
+	nodeLow, _ := t.lowLink.Get(node)
+	nodeIndex, _ := t.indexes.Get(node)
+
+	if nodeLow != nodeIndex {
+		return
+	}
+
+	members := []T{}
+
+	for {
+		member, _ := t.stack.Pop()
+		t.onStack.Remove(member)
+		members = append(members, member)
+
+		if member == node {
+			break
+		}
+	}
+
+	// stack returns members in reverse discovery order
+	component := NewSet[T]()
+	for i := len(members) - 1; i >= 0; i-- {
+		component.Add(members[i])
+	}
+
+	t.components = append(t.components, component)
+}
+
+func (t *graphTarjan[T]) lowerLink(node T, link int) {
+	if low, _ := t.lowLink.Get(node); link < low {
+		t.lowLink.Set(node, link)
+	}
+}
+
+func dotID(node any) string {
+	return strconv.Quote(fmt.Sprint(node))
+}
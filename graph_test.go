@@ -0,0 +1,100 @@
+package gds
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGraph_TopoSort(t *testing.T) {
+	g := NewGraph[string]()
+
+	g.AddNode("User")
+	g.AddNode("Status")
+	g.AddEdge("Role", "User")
+	g.AddEdge("Status", "Order")
+	g.AddEdge("User", "Order")
+
+	sorted, err := g.TopoSort()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Status", "Role", "User", "Order"}, sorted)
+
+	assert.Equal(t, []string{"User", "Status", "Role", "Order"}, g.Nodes())
+	assert.Equal(t, []string{"Order"}, g.Successors("User"))
+	assert.Equal(t, []string{}, g.Successors("Unknown"))
+	assert.True(t, g.HasEdge("Role", "User"))
+	assert.False(t, g.HasEdge("User", "Role"))
+}
+
+func TestGraph_TopoSortCycle(t *testing.T) {
+	g := NewGraph[string]()
+
+	g.AddEdge("a", "b")
+	g.AddEdge("b", "c")
+	g.AddEdge("c", "d")
+	g.AddEdge("d", "b")
+
+	_, err := g.TopoSort()
+	require.ErrorIs(t, err, ErrGraphCycle)
+	assert.Equal(t, "graph has a cycle: b -> c -> d -> b", err.Error())
+
+	g.RemoveEdge("d", "b")
+	g.AddEdge("d", "d")
+
+	_, err = g.TopoSort()
+	require.ErrorIs(t, err, ErrGraphCycle)
+	assert.Equal(t, "graph has a cycle: d -> d", err.Error())
+
+	assert.True(t, g.RemoveNode("d"))
+	assert.False(t, g.RemoveNode("d"))
+
+	sorted, err := g.TopoSort()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "c"}, sorted)
+}
+
+func TestGraph_StronglyConnectedComponents(t *testing.T) {
+	g := NewGraph[int]()
+
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 3)
+	g.AddEdge(3, 1)
+	g.AddEdge(3, 4)
+	g.AddEdge(4, 5)
+	g.AddEdge(5, 4)
+	g.AddNode(6)
+
+	components := [][]int{}
+	for _, component := range g.StronglyConnectedComponents() {
+		components = append(components, component.List())
+	}
+
+	assert.Equal(t, [][]int{{4, 5}, {1, 2, 3}, {6}}, components)
+}
+
+func TestGraph_Reachable(t *testing.T) {
+	g := NewGraph[int]()
+
+	g.AddEdge(1, 2)
+	g.AddEdge(1, 3)
+	g.AddEdge(3, 4)
+	g.AddEdge(4, 1)
+	g.AddEdge(5, 1)
+
+	assert.Equal(t, []int{2, 3, 4, 1}, g.Reachable(1).List())
+	assert.Equal(t, []int{}, g.Reachable(2).List())
+	assert.Equal(t, []int{}, g.Reachable(10).List())
+}
+
+func TestGraph_DOT(t *testing.T) {
+	var g Graph[string]
+
+	assert.True(t, g.IsEmpty())
+
+	g.AddEdge("a", `b"c`)
+	g.AddNode("d")
+
+	assert.Equal(t, 3, g.Len())
+	assert.Equal(t, "digraph {\n\t\"a\";\n\t\"b\\\"c\";\n\t\"d\";\n\t\"a\" -> \"b\\\"c\";\n}\n", g.DOT())
+}
@@ -0,0 +1,242 @@
+package gds
+
+import "iter"
+
+// List is a doubly linked list. The zero value is an empty list ready to use.
+type List[T any] struct {
+	root ListElement[T]
+	len  int
+
+	nilVal T
+}
+
+// ListElement is a handle of a List item.
+type ListElement[T any] struct {
+	Value T
+
+	next, prev *ListElement[T]
+	list       *List[T]
+}
+
+var _ Collection[int] = (*List[int])(nil)
+
+func NewList[T any](values ...T) *List[T] {
+	l := &List[T]{}
+
+	for _, value := range values {
+		l.PushBack(value)
+	}
+
+	return l
+}
+
+// Next returns the next element or nil.
+func (e *ListElement[T]) Next() *ListElement[T] {
+	if e.list == nil || e.next == &e.list.root {
+		return nil
+	}
+
+	return e.next
+}
+
+// Prev returns the previous element or nil.
+func (e *ListElement[T]) Prev() *ListElement[T] {
+	if e.list == nil || e.prev == &e.list.root {
+		return nil
+	}
+
+	return e.prev
+}
+
+// Front returns the first element or nil.
+func (l *List[T]) Front() *ListElement[T] {
+	if l.len == 0 {
+		return nil
+	}
+
+	return l.root.next
+}
+
+// Back returns the last element or nil.
+func (l *List[T]) Back() *ListElement[T] {
+	if l.len == 0 {
+		return nil
+	}
+
+	return l.root.prev
+}
+
+func (l *List[T]) PushFront(val T) *ListElement[T] {
+	l.lazyInit()
+
+	return l.insertAfter(&ListElement[T]{Value: val}, &l.root)
+}
+
+func (l *List[T]) PushBack(val T) *ListElement[T] {
+	l.lazyInit()
+
+	return l.insertAfter(&ListElement[T]{Value: val}, l.root.prev)
+}
+
+// InsertBefore inserts the value before mark. It returns nil when mark is not an element of the list.
+func (l *List[T]) InsertBefore(val T, mark *ListElement[T]) *ListElement[T] {
+	if mark.list != l {
+		return nil
+	}
+
+	return l.insertAfter(&ListElement[T]{Value: val}, mark.prev)
+}
+
+// InsertAfter inserts the value after mark. It returns nil when mark is not an element of the list.
+func (l *List[T]) InsertAfter(val T, mark *ListElement[T]) *ListElement[T] {
+	if mark.list != l {
+		return nil
+	}
+
+	return l.insertAfter(&ListElement[T]{Value: val}, mark)
+}
+
+// Remove deletes the element from the list and returns its value.
+func (l *List[T]) Remove(e *ListElement[T]) T {
+	if e.list == l {
+		l.unlink(e)
+	}
+
+	return e.Value
+}
+
+func (l *List[T]) MoveToFront(e *ListElement[T]) {
+	if e.list != l || l.root.next == e {
+		return
+	}
+
+	l.move(e, &l.root)
+}
+
+func (l *List[T]) MoveToBack(e *ListElement[T]) {
+	if e.list != l || l.root.prev == e {
+		return
+	}
+
+	l.move(e, l.root.prev)
+}
+
+func (l *List[T]) First() T {
+	if l.len == 0 {
+		return l.nilVal
+	}
+
+	return l.root.next.Value
+}
+
+func (l *List[T]) Len() int {
+	return l.len
+}
+
+func (l *List[T]) IsEmpty() bool {
+	return l.len == 0
+}
+
+func (l *List[T]) IsNotEmpty() bool {
+	return l.len > 0
+}
+
+func (l *List[T]) List() []T {
+	list := make([]T, 0, l.len)
+	for val := range l.All() {
+		list = append(list, val)
+	}
+
+	return list
+}
+
+// All returns an iterator over values from the front to the back.
+// The current element can be removed during iteration.
+func (l *List[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for e := l.Front(); e != nil; {
+			next := e.Next()
+			if !yield(e.Value) {
+				return
+			}
+			e = next
+		}
+	}
+}
+
+// Backward returns an iterator over values from the back to the front.
+func (l *List[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for e := l.Back(); e != nil; {
+			prev := e.Prev()
+			if !yield(e.Value) {
+				return
+			}
+			e = prev
+		}
+	}
+}
+
+// Elements returns an iterator over element handles from the front to the back.
+func (l *List[T]) Elements() iter.Seq[*ListElement[T]] {
+	return func(yield func(*ListElement[T]) bool) {
+		for e := l.Front(); e != nil; {
+			next := e.Next()
+			if !yield(e) {
+				return
+			}
+			e = next
+		}
+	}
+}
+
+func (l *List[T]) Clear() {
+	for e := l.Front(); e != nil; {
+		next := e.Next()
+		e.next, e.prev, e.list = nil, nil, nil
+		e = next
+	}
+
+	l.root.next = &l.root
+	l.root.prev = &l.root
+	l.len = 0
+}
+
+func (l *List[T]) lazyInit() {
+	if l.root.next == nil {
+		l.root.next = &l.root
+		l.root.prev = &l.root
+	}
+}
+
+func (l *List[T]) insertAfter(e, at *ListElement[T]) *ListElement[T] {
+	e.prev = at
+	e.next = at.next
+	e.prev.next = e
+	e.next.prev = e
+	e.list = l
+	l.len++
+
+	return e
+}
+
+func (l *List[T]) unlink(e *ListElement[T]) {
+	e.prev.next = e.next
+	e.next.prev = e.prev
+	e.next, e.prev, e.list = nil, nil, nil
+	l.len--
+}
+
+func (l *List[T]) move(e, at *ListElement[T]) {
+	if e == at {
+		return
+	}
+
+	e.prev.next = e.next
+	e.next.prev = e.prev
+
+	e.prev = at
+	e.next = at.next
+	e.prev.next = e
+	e.next.prev = e
+}
@@ -0,0 +1,102 @@
+package gds
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestList_PushInsert(t *testing.T) {
+	l := NewList(2, 4)
+
+	one := l.PushFront(1)
+	five := l.PushBack(5)
+	three := l.InsertAfter(3, l.Front().Next())
+	zero := l.InsertBefore(0, one)
+
+	require.NotNil(t, three)
+	require.NotNil(t, zero)
+
+	assert.Equal(t, []int{0, 1, 2, 3, 4, 5}, l.List())
+	assert.Equal(t, []int{5, 4, 3, 2, 1, 0}, slices.Collect(l.Backward()))
+	assert.Equal(t, 0, l.First())
+	assert.Equal(t, 6, l.Len())
+	assert.Same(t, zero, l.Front())
+	assert.Same(t, five, l.Back())
+	assert.Nil(t, l.Front().Prev())
+	assert.Nil(t, l.Back().Next())
+}
+
+func TestList_RemoveMove(t *testing.T) {
+	l := NewList[string]()
+
+	a := l.PushBack("a")
+	b := l.PushBack("b")
+	c := l.PushBack("c")
+
+	l.MoveToFront(c)
+	assert.Equal(t, []string{"c", "a", "b"}, l.List())
+
+	l.MoveToBack(c)
+	assert.Equal(t, []string{"a", "b", "c"}, l.List())
+
+	assert.Equal(t, "b", l.Remove(b))
+	assert.Equal(t, []string{"a", "c"}, l.List())
+	assert.Nil(t, b.Next())
+	assert.Nil(t, b.Prev())
+
+	// removed element is no longer a handle of the list
+	assert.Equal(t, "b", l.Remove(b))
+	assert.Nil(t, l.InsertAfter("x", b))
+	l.MoveToFront(b)
+	assert.Equal(t, 2, l.Len())
+
+	// element of another list is ignored
+	other := NewList("z")
+	l.Remove(other.Front())
+	l.MoveToBack(other.Front())
+	assert.Nil(t, l.InsertBefore("x", other.Front()))
+	assert.Equal(t, []string{"a", "c"}, l.List())
+	assert.Equal(t, 1, other.Len())
+
+	l.Remove(a)
+	l.Remove(c)
+	assert.True(t, l.IsEmpty())
+	assert.Nil(t, l.Front())
+	assert.Nil(t, l.Back())
+}
+
+func TestList_ZeroValue(t *testing.T) {
+	var l List[int]
+
+	assert.True(t, l.IsEmpty())
+	assert.False(t, l.IsNotEmpty())
+	assert.Equal(t, 0, l.First())
+	assert.Equal(t, []int{}, l.List())
+	assert.Nil(t, l.Front())
+
+	l.PushFront(2)
+	l.PushFront(1)
+	assert.Equal(t, []int{1, 2}, l.List())
+}
+
+func TestList_RemoveDuringIteration(t *testing.T) {
+	l := NewList(1, 2, 3, 4, 5)
+
+	for e := range l.Elements() {
+		if e.Value%2 == 0 {
+			l.Remove(e)
+		}
+	}
+
+	assert.Equal(t, []int{1, 3, 5}, l.List())
+
+	l.Clear()
+	assert.True(t, l.IsEmpty())
+	assert.Empty(t, slices.Collect(l.All()))
+
+	l.PushBack(7)
+	assert.Equal(t, []int{7}, l.List())
+}
@@ -0,0 +1,124 @@
+package gds
+
+// LRU is a cache with limited capacity which evicts the least recently used entry.
+// It is built on Map, where entries are ordered from the least to the most recently used.
+type LRU[K comparable, V any] struct {
+	capacity int
+	entries  *Map[K, V]
+
+	onEvict []func(key K, val V)
+	stats   LRUStats
+}
+
+type LRUStats struct {
+	Hits      uint64
+	Misses    uint64
+	Evictions uint64
+}
+
+func NewLRU[K comparable, V any](capacity int) *LRU[K, V] {
+	if capacity < 1 {
+		panic("gds: LRU capacity must be positive")
+	}
+
+	return &LRU[K, V]{
+		capacity: capacity,
+		entries:  newMapWithCapacity[K, V](capacity),
+	}
+}
+
+// OnEvict registers a callback which is called when an entry is evicted because of capacity.
+func (c *LRU[K, V]) OnEvict(callback func(key K, val V)) {
+	c.onEvict = append(c.onEvict, callback)
+}
+
+// Get returns the value and marks the entry as the most recently used.
+func (c *LRU[K, V]) Get(key K) (V, bool) {
+	val, has := c.entries.Get(key)
+	if !has {
+		c.stats.Misses++
+
+		return val, false
+	}
+
+	c.stats.Hits++
+	c.entries.MoveToBack(key)
+
+	return val, true
+}
+
+// Peek returns the value without marking the entry as used and without updating stats.
+func (c *LRU[K, V]) Peek(key K) (V, bool) {
+	return c.entries.Get(key)
+}
+
+func (c *LRU[K, V]) Has(key K) bool {
+	return c.entries.Has(key)
+}
+
+// Set adds or updates the entry, marks it as the most recently used and evicts entries over capacity.
+func (c *LRU[K, V]) Set(key K, val V) {
+	if c.entries.Has(key) {
+		c.entries.Set(key, val)
+		c.entries.MoveToBack(key)
+
+		return
+	}
+
+	c.entries.Set(key, val)
+	c.evictOverCapacity()
+}
+
+func (c *LRU[K, V]) Delete(key K) bool {
+	if !c.entries.Has(key) {
+		return false
+	}
+
+	c.entries.Delete(key)
+
+	return true
+}
+
+// Resize changes capacity and evicts entries over the new capacity.
+func (c *LRU[K, V]) Resize(capacity int) {
+	if capacity < 1 {
+		panic("gds: LRU capacity must be positive")
+	}
+
+	c.capacity = capacity
+	c.evictOverCapacity()
+}
+
+func (c *LRU[K, V]) Purge() {
+	c.entries = newMapWithCapacity[K, V](c.capacity)
+}
+
+func (c *LRU[K, V]) Len() int {
+	return c.entries.Len()
+}
+
+func (c *LRU[K, V]) Cap() int {
+	return c.capacity
+}
+
+// Keys returns keys from the least to the most recently used.
+func (c *LRU[K, V]) Keys() []K {
+	return c.entries.Keys()
+}
+
+func (c *LRU[K, V]) Stats() LRUStats {
+	return c.stats
+}
+
+func (c *LRU[K, V]) evictOverCapacity() {
+	for c.entries.Len() > c.capacity {
+		// the oldest entry is deleted outside of iteration, so Map compacts its tombstones
+		key, val := c.entries.At(0)
+		c.entries.Delete(key)
+		c.stats.Evictions++
+
+		for _, callback := range c.onEvict {
+			callback(key, val)
+		}
+	}
+}
@@ -0,0 +1,150 @@
+package gds
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLRU(t *testing.T) {
+	t.Run("evicts least recently used", func(t *testing.T) {
+		cache := NewLRU[string, int](2)
+
+		evicted := []string{}
+		cache.OnEvict(func(key string, _ int) {
+			evicted = append(evicted, key)
+		})
+
+		cache.Set("a", 1)
+		cache.Set("b", 2)
+
+		_, ok := cache.Get("a")
+		require.True(t, ok)
+
+		cache.Set("c", 3)
+
+		assert.Equal(t, []string{"b"}, evicted)
+		assert.Equal(t, []string{"a", "c"}, cache.Keys())
+		assert.False(t, cache.Has("b"))
+	})
+
+	t.Run("set promotes existing key", func(t *testing.T) {
+		cache := NewLRU[string, int](2)
+
+		cache.Set("a", 1)
+		cache.Set("b", 2)
+		cache.Set("a", 10)
+		cache.Set("c", 3)
+
+		assert.Equal(t, []string{"a", "c"}, cache.Keys())
+
+		got, _ := cache.Peek("a")
+		assert.Equal(t, 10, got)
+	})
+
+	t.Run("peek doesn't promote", func(t *testing.T) {
+		cache := NewLRU[string, int](2)
+
+		cache.Set("a", 1)
+		cache.Set("b", 2)
+
+		got, ok := cache.Peek("a")
+		require.True(t, ok)
+		assert.Equal(t, 1, got)
+
+		cache.Set("c", 3)
+
+		assert.Equal(t, []string{"b", "c"}, cache.Keys())
+		assert.Equal(t, LRUStats{Evictions: 1}, cache.Stats())
+	})
+
+	t.Run("stats", func(t *testing.T) {
+		cache := NewLRU[string, int](1)
+
+		cache.Set("a", 1)
+		cache.Get("a")
+		cache.Get("a")
+		cache.Get("b")
+		cache.Set("b", 2)
+
+		assert.Equal(t, LRUStats{Hits: 2, Misses: 1, Evictions: 1}, cache.Stats())
+	})
+
+	t.Run("resize", func(t *testing.T) {
+		cache := NewLRU[int, int](5)
+
+		for i := 0; i < 5; i++ {
+			cache.Set(i, i)
+		}
+
+		cache.Resize(2)
+
+		assert.Equal(t, []int{3, 4}, cache.Keys())
+		assert.Equal(t, 2, cache.Cap())
+	})
+
+	t.Run("delete and purge", func(t *testing.T) {
+		cache := NewLRU[int, int](3)
+
+		cache.Set(1, 1)
+		cache.Set(2, 2)
+
+		assert.True(t, cache.Delete(1))
+		assert.False(t, cache.Delete(1))
+		assert.Equal(t, 1, cache.Len())
+
+		cache.Purge()
+		assert.Equal(t, 0, cache.Len())
+	})
+
+	t.Run("many operations", func(t *testing.T) {
+		cache := NewLRU[int, int](10)
+
+		for i := 0; i < 10000; i++ {
+			cache.Set(i%37, i)
+			cache.Get((i * 7) % 37)
+		}
+
+		assert.Equal(t, 10, cache.Len())
+		assert.Len(t, cache.Keys(), 10)
+	})
+
+	t.Run("evictions don't grow entries", func(t *testing.T) {
+		cache := NewLRU[int, int](4)
+
+		for i := 0; i < 100000; i++ {
+			cache.Set(i, i)
+		}
+
+		assert.Equal(t, []int{99996, 99997, 99998, 99999}, cache.Keys())
+		assert.LessOrEqual(t, len(cache.entries.entries), 2*cache.Cap())
+	})
+
+	t.Run("caches string conversions", func(t *testing.T) {
+		cache := NewLRU[string, *String](2)
+		calls := 0
+
+		pascal := func(s string) *String {
+			if cached, ok := cache.Get(s); ok {
+				return cached
+			}
+
+			calls++
+			converted := NewString(s).Pascal()
+			cache.Set(s, converted)
+
+			return converted
+		}
+
+		assert.Equal(t, "UserId", pascal("user_id").Value)
+		assert.Equal(t, "UserId", pascal("user_id").Value)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("invalid capacity", func(t *testing.T) {
+		assert.Panics(t, func() {
+			NewLRU[int, int](0)
+		})
+	})
+}
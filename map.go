@@ -1,64 +1,134 @@
 package gds
 
 import (
+	"bytes"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
 	"fmt"
 	"gopkg.in/yaml.v3"
+	"iter"
+	"maps"
 	"reflect"
 	"slices"
+	"strconv"
+	"sync/atomic"
 )
 
+// Map keeps entries in insertion order. The zero value is an empty map ready to use.
+//
+// Deleted entries are marked as tombstones and are removed from entries in one pass
+// when they make up more than a half of it, so Delete is amortized O(1).
+// Compaction is postponed while All or Backward is running, so deleting entries during iteration doesn't skip the rest,
+// and runs when the last iteration ends.
 type Map[K comparable, V any] struct {
 	keyIndex map[K]int
+	entries  []mapEntry[K, V]
 
-	keys   []K
-	values []V
-	mapped map[K]V
+	tombstones int
+	head       int
+	// iterators counts running All and Backward loops. It is changed atomically, because reads are safe under RLock.
+	iterators int32
+	// compactPostponed is set by Delete during iteration, so loops which only read never write to the map.
+	compactPostponed bool
 
 	nilVal V
 }
 
+type Entry[K comparable, V any] struct {
+	Key   K `json:"key" yaml:"key"`
+	Value V `json:"value" yaml:"value"`
+}
+
+type mapEntry[K comparable, V any] struct {
+	key     K
+	value   V
+	deleted bool
+}
+
 func NewMap[K comparable, V any]() *Map[K, V] {
 	return NewMapFrom[K, V](map[K]V{})
 }
 
+// NewMapFrom copies the built-in map in its iteration order, which is random.
+// Use NewMapFromSortedMap or NewMapFromEntries to get a deterministic order.
 func NewMapFrom[K comparable, V any](val map[K]V) *Map[K, V] {
-	keys := []K{}
-	values := []V{}
-	keyIndex := map[K]int{}
-	mapped := map[K]V{}
-
-	i := 0
+	m := newMapWithCapacity[K, V](len(val))
 
 	for k, v := range val {
-		keyIndex[k] = i
-		keys = append(keys, k)
-		values = append(values, v)
-		mapped[k] = v
+		m.Set(k, v)
+	}
+
+	return m
+}
+
+// NewMapFromEntries creates a map with entries in the given order. A repeated key keeps its first position.
+func NewMapFromEntries[K comparable, V any](entries ...Entry[K, V]) *Map[K, V] {
+	m := newMapWithCapacity[K, V](len(entries))
 
-		i++
+	for _, entry := range entries {
+		m.Set(entry.Key, entry.Value)
 	}
 
+	return m
+}
+
+// NewMapFromSortedMap copies the built-in map with keys sorted by cmp.
+func NewMapFromSortedMap[K comparable, V any](val map[K]V, cmp func(a, b K) int) *Map[K, V] {
+	keys := slices.SortedFunc(maps.Keys(val), cmp)
+	m := newMapWithCapacity[K, V](len(keys))
+
+	for _, key := range keys {
+		m.Set(key, val[key])
+	}
+
+	return m
+}
+
+func newMapWithCapacity[K comparable, V any](size int) *Map[K, V] {
 	return &Map[K, V]{
-		keyIndex: keyIndex,
-		keys:     keys,
-		values:   values,
-		mapped:   mapped,
+		keyIndex: make(map[K]int, size),
+		entries:  make([]mapEntry[K, V], 0, size),
+	}
+}
+
+func NewMapFromSeq[K comparable, V any](seq iter.Seq2[K, V]) *Map[K, V] {
+	m := NewMap[K, V]()
+
+	for k, v := range seq {
+		m.Set(k, v)
 	}
+
+	return m
 }
 
 func (m *Map[K, V]) First() V {
 	if m.IsEmpty() {
 		return m.nilVal
 	}
-	return m.values[0]
+	return m.entries[m.head].value
 }
 
 func (m *Map[K, V]) Clone() *Map[K, V] {
-	return &Map[K, V]{
-		keyIndex: m.keyIndex,
-		keys:     m.keys,
-		values:   m.values,
+	return m.CloneFunc(func(v V) V {
+		return v
+	})
+}
+
+// CloneFunc returns a copy of the map with values passed through cloner,
+// e.g. to copy values behind pointers.
+func (m *Map[K, V]) CloneFunc(cloner func(V) V) *Map[K, V] {
+	newMap := newMapWithCapacity[K, V](m.Len())
+
+	for k, v := range m.All() {
+		newMap.keyIndex[k] = len(newMap.entries)
+		newMap.entries = append(newMap.entries, mapEntry[K, V]{
+			key:   k,
+			value: cloner(v),
+		})
 	}
+
+	return newMap
 }
 
 func (m *Map[K, V]) Has(key K) bool {
@@ -67,31 +137,107 @@ func (m *Map[K, V]) Has(key K) bool {
 }
 
 func (m *Map[K, V]) List() []V {
-	return m.values
+	values := make([]V, 0, m.Len())
+	for _, v := range m.All() {
+		values = append(values, v)
+	}
+
+	return values
 }
 
 func (m *Map[K, V]) Keys() []K {
-	return m.keys
+	keys := make([]K, 0, m.Len())
+	for k := range m.All() {
+		keys = append(keys, k)
+	}
+
+	return keys
+}
+
+func (m *Map[K, V]) Entries() []Entry[K, V] {
+	entries := make([]Entry[K, V], 0, m.Len())
+	for k, v := range m.All() {
+		entries = append(entries, Entry[K, V]{Key: k, Value: v})
+	}
+
+	return entries
+}
+
+func (m *Map[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		atomic.AddInt32(&m.iterators, 1)
+		defer m.endIteration()
+
+		for i := m.head; i < len(m.entries); i++ {
+			if m.entries[i].deleted {
+				continue
+			}
+
+			if !yield(m.entries[i].key, m.entries[i].value) {
+				return
+			}
+		}
+	}
+}
+
+func (m *Map[K, V]) Backward() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		atomic.AddInt32(&m.iterators, 1)
+		defer m.endIteration()
+
+		for i := len(m.entries) - 1; i >= 0; i-- {
+			if i >= len(m.entries) || m.entries[i].deleted {
+				continue
+			}
+
+			if !yield(m.entries[i].key, m.entries[i].value) {
+				return
+			}
+		}
+	}
+}
+
+// KeysSeq returns an iterator over keys in insertion order.
+// Unlike Keys it doesn't copy keys to a slice.
+func (m *Map[K, V]) KeysSeq() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range m.All() {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+func (m *Map[K, V]) Values() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, v := range m.All() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
 }
 
 func (m *Map[K, V]) Set(key K, val V) {
+	m.lazyInit()
+
 	id, has := m.keyIndex[key]
-	if !has {
-		m.keyIndex[key] = len(m.values)
-		m.keys = append(m.keys, key)
-		m.values = append(m.values, val)
-		m.mapped[key] = val
+	if has {
+		m.entries[id].value = val
 
 		return
 	}
 
-	m.keys[id] = key
-	m.values[id] = val
-	m.mapped[key] = val
+	m.keyIndex[key] = len(m.entries)
+	m.entries = append(m.entries, mapEntry[K, V]{
+		key:   key,
+		value: val,
+	})
 }
 
 func (m *Map[K, V]) Len() int {
-	return len(m.values)
+	return len(m.keyIndex)
 }
 
 func (m *Map[K, V]) Get(key K) (V, bool) {
@@ -100,7 +246,7 @@ func (m *Map[K, V]) Get(key K) (V, bool) {
 		return m.nilVal, false
 	}
 
-	return m.values[id], true
+	return m.entries[id].value, true
 }
 
 func (m *Map[K, V]) Equal(that *Map[K, V]) bool {
@@ -114,7 +260,7 @@ func (m *Map[K, V]) Equal(that *Map[K, V]) bool {
 			return false
 		}
 
-		if !reflect.DeepEqual(v, m.values[id]) {
+		if !reflect.DeepEqual(v, m.entries[id].value) {
 			return false
 		}
 	}
@@ -123,96 +269,79 @@ func (m *Map[K, V]) Equal(that *Map[K, V]) bool {
 }
 
 func (m *Map[K, V]) Walk(callback func(key K, val V) bool) {
-	for k, id := range m.keyIndex {
-		continueWalk := callback(k, m.values[id])
+	for k, v := range m.All() {
+		continueWalk := callback(k, v)
 		if !continueWalk {
 			return
 		}
 	}
 }
 
-func (m *Map[K, V]) Delete(key K) {
-	id, has := m.keyIndex[key]
-	if !has {
-		return
+func (m *Map[K, V]) WalkReverse(callback func(key K, val V) bool) {
+	for k, v := range m.Backward() {
+		continueWalk := callback(k, v)
+		if !continueWalk {
+			return
+		}
 	}
+}
 
-	deletingKey := m.keys[id]
-
-	values := m.values[0:id:id]
-	keys := m.keys[0:id:id]
-
-	delete(m.keyIndex, deletingKey)
-	delete(m.mapped, deletingKey)
+// WalkErr calls callback for each entry in insertion order and stops at the first error.
+func (m *Map[K, V]) WalkErr(callback func(key K, val V) error) error {
+	for k, v := range m.All() {
+		if err := callback(k, v); err != nil {
+			return err
+		}
+	}
 
-	for i := id + 1; i < len(m.values); i++ {
-		values = append(values, m.values[i])
-		keys = append(keys, m.keys[i])
+	return nil
+}
 
-		m.keyIndex[m.keys[i]]--
+func (m *Map[K, V]) Delete(key K) {
+	id, has := m.keyIndex[key]
+	if !has {
+		return
 	}
 
-	m.values = values
-	m.keys = keys
+	m.deleteAt(id)
+	m.compactIfNeeded()
 }
 
 func (m *Map[K, V]) ToMap() map[K]V {
-	return m.mapped
+	mapped := make(map[K]V, m.Len())
+	for k, v := range m.All() {
+		mapped[k] = v
+	}
+
+	return mapped
 }
 
 func (m *Map[K, V]) IsEmpty() bool {
-	return len(m.values) == 0
+	return m.Len() == 0
 }
 
 func (m *Map[K, V]) IsNotEmpty() bool {
-	return len(m.values) > 0
+	return m.Len() > 0
 }
 
 func (m *Map[K, V]) DeleteMany(delkeys []K) {
-	if m.IsEmpty() || len(delkeys) == 0 {
-		return
-	}
-
-	ids := make([]int, 0, len(delkeys))
 	for _, key := range delkeys {
-		id, ok := m.keyIndex[key]
-		if ok {
-			ids = append(ids, id)
-
-			delete(m.keyIndex, key)
-			delete(m.mapped, key)
-		}
-	}
-
-	slices.Sort(ids)
-
-	values := m.values[0:ids[0]:ids[0]]
-	keys := m.keys[0:ids[0]:ids[0]]
-
-	for i := ids[0]; i < len(m.values); i++ {
-		if len(ids) > 0 && ids[0] == i {
-			ids = ids[1:]
-
-			continue
+		id, has := m.keyIndex[key]
+		if has {
+			m.deleteAt(id)
 		}
-
-		values = append(values, m.values[i])
-		keys = append(keys, m.keys[i])
-
-		m.keyIndex[m.keys[i]]--
 	}
 
-	m.values = values
-	m.keys = keys
+	m.compactIfNeeded()
 }
 
 func (m *Map[K, V]) Keep(keys ...K) {
 	newMap := m.CloneAndKeep(keys...)
 
 	m.keyIndex = newMap.keyIndex
-	m.keys = newMap.keys
-	m.values = newMap.values
-	m.mapped = newMap.mapped
+	m.entries = newMap.entries
+	m.tombstones = newMap.tombstones
+	m.head = newMap.head
 }
 
 func (m *Map[K, V]) CloneAndKeep(keys ...K) *Map[K, V] {
@@ -229,16 +358,92 @@ func (m *Map[K, V]) CloneAndKeep(keys ...K) *Map[K, V] {
 	return newMap
 }
 
+// reset removes all entries, running iterators stay counted.
+func (m *Map[K, V]) reset() {
+	m.keyIndex = make(map[K]int)
+	m.entries = nil
+	m.tombstones = 0
+	m.head = 0
+	m.compactPostponed = false
+}
+
+func (m *Map[K, V]) lazyInit() {
+	if m.keyIndex == nil {
+		m.keyIndex = make(map[K]int)
+	}
+}
+
+func (m *Map[K, V]) deleteAt(id int) {
+	delete(m.keyIndex, m.entries[id].key)
+
+	m.entries[id] = mapEntry[K, V]{deleted: true}
+	m.tombstones++
+
+	for m.head < len(m.entries) && m.entries[m.head].deleted {
+		m.head++
+	}
+
+	for len(m.entries) > 0 && m.entries[len(m.entries)-1].deleted {
+		m.entries = m.entries[:len(m.entries)-1]
+		m.tombstones--
+	}
+
+	m.head = min(m.head, len(m.entries))
+}
+
+func (m *Map[K, V]) compactIfNeeded() {
+	if m.tombstones <= len(m.entries)/2 {
+		return
+	}
+
+	if atomic.LoadInt32(&m.iterators) > 0 {
+		m.compactPostponed = true
+
+		return
+	}
+
+	m.compact()
+}
+
+func (m *Map[K, V]) endIteration() {
+	if atomic.AddInt32(&m.iterators, -1) > 0 || !m.compactPostponed {
+		return
+	}
+
+	m.compactPostponed = false
+	m.compactIfNeeded()
+}
+
+func (m *Map[K, V]) compact() {
+	if m.tombstones == 0 {
+		return
+	}
+
+	entries := m.entries[:0]
+
+	for _, entry := range m.entries {
+		if entry.deleted {
+			continue
+		}
+
+		m.keyIndex[entry.key] = len(entries)
+		entries = append(entries, entry)
+	}
+
+	clear(m.entries[len(entries):])
+
+	m.entries = entries
+	m.tombstones = 0
+	m.head = 0
+	m.compactPostponed = false
+}
+
 func (m *Map[K, V]) UnmarshalYAML(n *yaml.Node) error {
 	if n.Kind != yaml.MappingNode {
 		return fmt.Errorf("yaml must contain a mapping node, has %v", n.Kind)
 	}
 
-	if m.mapped == nil {
-		m.mapped = make(map[K]V)
-		m.keyIndex = make(map[K]int)
-		m.keys = make([]K, 0)
-	}
+	m.lazyInit()
 
 	for i := 0; i < len(n.Content); i += 2 {
 		var (
@@ -246,12 +451,12 @@ func (m *Map[K, V]) UnmarshalYAML(n *yaml.Node) error {
 			value V
 		)
 
-		err := yaml.Unmarshal([]byte(n.Content[i].Value), &key)
+		err := n.Content[i].Decode(&key)
 		if err != nil {
 			return fmt.Errorf("unmarshal key: %w", err)
 		}
 
-		err = yaml.Unmarshal([]byte(n.Content[i+1].Value), &value)
+		err = n.Content[i+1].Decode(&value)
 		if err != nil {
 			return fmt.Errorf("unmarshal value: %w", err)
 		}
@@ -261,3 +466,202 @@ func (m *Map[K, V]) UnmarshalYAML(n *yaml.Node) error {
 
 	return nil
 }
+
+func (m *Map[K, V]) MarshalYAML() (interface{}, error) {
+	node := &yaml.Node{
+		Kind:    yaml.MappingNode,
+		Tag:     "!!map",
+		Content: make([]*yaml.Node, 0, m.Len()*2), //nolint:mnd // key and value nodes
+	}
+
+	for key, value := range m.All() {
+		keyNode := &yaml.Node{}
+		if err := keyNode.Encode(key); err != nil {
+			return nil, fmt.Errorf("marshal key: %w", err)
+		}
+
+		valueNode := &yaml.Node{}
+		if err := valueNode.Encode(value); err != nil {
+			return nil, fmt.Errorf("marshal value: %w", err)
+		}
+
+		node.Content = append(node.Content, keyNode, valueNode)
+	}
+
+	return node, nil
+}
+
+func (m *Map[K, V]) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+
+	buf.WriteByte('{')
+
+	for key, value := range m.All() {
+		if buf.Len() > 1 {
+			buf.WriteByte(',')
+		}
+
+		rawKey, err := marshalMapKey(key)
+		if err != nil {
+			return nil, fmt.Errorf("marshal key: %w", err)
+		}
+
+		keyJSON, err := json.Marshal(rawKey)
+		if err != nil {
+			return nil, fmt.Errorf("marshal key: %w", err)
+		}
+
+		valueJSON, err := json.Marshal(value)
+		if err != nil {
+			return nil, fmt.Errorf("marshal value of key %q: %w", rawKey, err)
+		}
+
+		buf.Write(keyJSON)
+		buf.WriteByte(':')
+		buf.Write(valueJSON)
+	}
+
+	buf.WriteByte('}')
+
+	return buf.Bytes(), nil
+}
+
+func (m *Map[K, V]) UnmarshalJSON(data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+
+	tok, err := dec.Token()
+	if err != nil {
+		return fmt.Errorf("read json: %w", err)
+	}
+
+	if tok == nil {
+		return nil
+	}
+
+	if delim, ok := tok.(json.Delim); !ok || delim != '{' {
+		return fmt.Errorf("json must contain an object, has %v", tok)
+	}
+
+	m.lazyInit()
+
+	for dec.More() {
+		tok, err = dec.Token()
+		if err != nil {
+			return fmt.Errorf("read key: %w", err)
+		}
+
+		rawKey, ok := tok.(string)
+		if !ok {
+			return fmt.Errorf("json object key must be a string, has %v", tok)
+		}
+
+		var (
+			key   K
+			value V
+		)
+
+		if err = unmarshalMapKey(rawKey, &key); err != nil {
+			return fmt.Errorf("unmarshal key %q: %w", rawKey, err)
+		}
+
+		if err = dec.Decode(&value); err != nil {
+			return fmt.Errorf("unmarshal value of key %q: %w", rawKey, err)
+		}
+
+		m.Set(key, value)
+	}
+
+	if _, err = dec.Token(); err != nil {
+		return fmt.Errorf("read json: %w", err)
+	}
+
+	return nil
+}
+
+// Scan reads the map from a JSON object, e.g. a json or jsonb column. NULL gives an empty map.
+func (m *Map[K, V]) Scan(src any) error {
+	m.reset()
+
+	switch v := src.(type) {
+	case nil:
+		return nil
+	case string:
+		return m.UnmarshalJSON([]byte(v))
+	case []byte:
+		return m.UnmarshalJSON(v)
+	default:
+		return fmt.Errorf("unexpected type %q", reflect.TypeOf(src).String())
+	}
+}
+
+// Value writes the map as a JSON object, nil map is written as NULL.
+func (m *Map[K, V]) Value() (driver.Value, error) {
+	if m == nil {
+		return nil, nil //nolint:nilnil // NULL value
+	}
+
+	return m.MarshalJSON()
+}
+
+// marshalMapKey converts a map key to a JSON object key by the same rules as encoding/json:
+// string kinds are used directly, then encoding.TextMarshaler, then integers.
+func marshalMapKey(key any) (string, error) {
+	rv := reflect.ValueOf(key)
+	if !rv.IsValid() {
+		return "", fmt.Errorf("unsupported key %v", key)
+	}
+
+	if rv.Kind() == reflect.String {
+		return rv.String(), nil
+	}
+
+	if tm, ok := key.(encoding.TextMarshaler); ok {
+		text, err := tm.MarshalText()
+		if err != nil {
+			return "", err
+		}
+
+		return string(text), nil
+	}
+
+	switch rv.Kind() { //nolint:exhaustive // other kinds are not supported
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(rv.Uint(), 10), nil
+	default:
+		return "", fmt.Errorf("unsupported key type %q", rv.Type().String())
+	}
+}
+
+// unmarshalMapKey is the reverse of marshalMapKey, key must be a pointer.
+func unmarshalMapKey(raw string, key any) error {
+	if tu, ok := key.(encoding.TextUnmarshaler); ok {
+		return tu.UnmarshalText([]byte(raw))
+	}
+
+	rv := reflect.ValueOf(key).Elem()
+
+	switch rv.Kind() { //nolint:exhaustive // other kinds are not supported
+	case reflect.String:
+		rv.SetString(raw)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(raw, 10, rv.Type().Bits())
+		if err != nil {
+			return err
+		}
+
+		rv.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, err := strconv.ParseUint(raw, 10, rv.Type().Bits())
+		if err != nil {
+			return err
+		}
+
+		rv.SetUint(n)
+	default:
+		return fmt.Errorf("unsupported key type %q", rv.Type().String())
+	}
+
+	return nil
+}
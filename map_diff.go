@@ -0,0 +1,107 @@
+package gds
+
+import "reflect"
+
+type ValueChange[K comparable, V any] struct {
+	Key K `json:"key" yaml:"key"`
+	Old V `json:"old" yaml:"old"`
+	New V `json:"new" yaml:"new"`
+}
+
+// Patch describes changes between two maps.
+type Patch[K comparable, V any] struct {
+	Added   []Entry[K, V]       `json:"added,omitempty" yaml:"added,omitempty"`
+	Removed []Entry[K, V]       `json:"removed,omitempty" yaml:"removed,omitempty"`
+	Changed []ValueChange[K, V] `json:"changed,omitempty" yaml:"changed,omitempty"`
+
+	// Order is the key order of the target map.
+	// It is set only when applying other changes doesn't reproduce the order.
+	Order []K `json:"order,omitempty" yaml:"order,omitempty"`
+}
+
+// Diff returns changes which turn a into b. Values are compared by reflect.DeepEqual.
+// Removed and changed entries follow the order of a, added entries follow the order of b.
+func Diff[K comparable, V any](a, b *Map[K, V]) *Patch[K, V] {
+	patch := &Patch[K, V]{}
+	order := make([]K, 0, b.Len())
+
+	for k, oldVal := range a.All() {
+		newVal, has := b.Get(k)
+		if !has {
+			patch.Removed = append(patch.Removed, Entry[K, V]{Key: k, Value: oldVal})
+
+			continue
+		}
+
+		order = append(order, k)
+
+		if !reflect.DeepEqual(oldVal, newVal) {
+			patch.Changed = append(patch.Changed, ValueChange[K, V]{Key: k, Old: oldVal, New: newVal})
+		}
+	}
+
+	for k, newVal := range b.All() {
+		if !a.Has(k) {
+			patch.Added = append(patch.Added, Entry[K, V]{Key: k, Value: newVal})
+			order = append(order, k)
+		}
+	}
+
+	i := 0
+	for k := range b.All() {
+		if order[i] != k {
+			patch.Order = b.Keys()
+
+			break
+		}
+		i++
+	}
+
+	return patch
+}
+
+func (p *Patch[K, V]) IsEmpty() bool {
+	return len(p.Added) == 0 && len(p.Removed) == 0 && len(p.Changed) == 0 && len(p.Order) == 0
+}
+
+func (p *Patch[K, V]) OrderChanged() bool {
+	return len(p.Order) > 0
+}
+
+// Apply changes the map. Applying the patch to the first map passed to Diff makes it equal
+// to the second one, including key order.
+func (p *Patch[K, V]) Apply(m *Map[K, V]) {
+	for _, entry := range p.Removed {
+		m.Delete(entry.Key)
+	}
+
+	for _, change := range p.Changed {
+		m.Set(change.Key, change.New)
+	}
+
+	for _, entry := range p.Added {
+		m.Set(entry.Key, entry.Value)
+	}
+
+	if !p.OrderChanged() {
+		return
+	}
+
+	positions := make(map[K]int, len(p.Order))
+	for i, k := range p.Order {
+		positions[k] = i
+	}
+
+	m.SortFunc(func(a, b Entry[K, V]) int {
+		return orderPosition(positions, a.Key) - orderPosition(positions, b.Key)
+	})
+}
+
+func orderPosition[K comparable](positions map[K]int, key K) int {
+	pos, has := positions[key]
+	if !has {
+		return len(positions)
+	}
+
+	return pos
+}
@@ -0,0 +1,152 @@
+package gds
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiff(t *testing.T) {
+	cases := []struct {
+		Title    string
+		Old      []Entry[string, string]
+		New      []Entry[string, string]
+		Expected *Patch[string, string]
+	}{
+		{
+			Title: "equal maps",
+			Old: []Entry[string, string]{
+				{Key: "id", Value: "int"},
+				{Key: "name", Value: "text"},
+			},
+			New: []Entry[string, string]{
+				{Key: "id", Value: "int"},
+				{Key: "name", Value: "text"},
+			},
+			Expected: &Patch[string, string]{},
+		},
+		{
+			Title: "added, removed and changed",
+			Old: []Entry[string, string]{
+				{Key: "id", Value: "int"},
+				{Key: "name", Value: "text"},
+				{Key: "age", Value: "int"},
+			},
+			New: []Entry[string, string]{
+				{Key: "id", Value: "bigint"},
+				{Key: "name", Value: "text"},
+				{Key: "email", Value: "text"},
+			},
+			Expected: &Patch[string, string]{
+				Added: []Entry[string, string]{
+					{Key: "email", Value: "text"},
+				},
+				Removed: []Entry[string, string]{
+					{Key: "age", Value: "int"},
+				},
+				Changed: []ValueChange[string, string]{
+					{Key: "id", Old: "int", New: "bigint"},
+				},
+			},
+		},
+		{
+			Title: "order changed",
+			Old: []Entry[string, string]{
+				{Key: "id", Value: "int"},
+				{Key: "name", Value: "text"},
+			},
+			New: []Entry[string, string]{
+				{Key: "name", Value: "text"},
+				{Key: "id", Value: "int"},
+			},
+			Expected: &Patch[string, string]{
+				Order: []string{"name", "id"},
+			},
+		},
+		{
+			Title: "added before existing",
+			Old: []Entry[string, string]{
+				{Key: "id", Value: "int"},
+			},
+			New: []Entry[string, string]{
+				{Key: "uuid", Value: "uuid"},
+				{Key: "id", Value: "int"},
+			},
+			Expected: &Patch[string, string]{
+				Added: []Entry[string, string]{
+					{Key: "uuid", Value: "uuid"},
+				},
+				Order: []string{"uuid", "id"},
+			},
+		},
+	}
+
+	for _, tCase := range cases {
+		t.Run(tCase.Title, func(t *testing.T) {
+			old := NewMapFromEntries(tCase.Old...)
+			updated := NewMapFromEntries(tCase.New...)
+
+			patch := Diff(old, updated)
+
+			assert.Equal(t, tCase.Expected, patch)
+
+			patch.Apply(old)
+
+			assert.Equal(t, updated.Keys(), old.Keys())
+			assert.Equal(t, updated.List(), old.List())
+		})
+	}
+}
+
+func TestPatch_IsEmpty(t *testing.T) {
+	old := NewMap[string, string]()
+	old.Set("id", "int")
+
+	updated := NewMap[string, string]()
+	updated.Set("id", "int")
+
+	assert.True(t, Diff(old, updated).IsEmpty())
+
+	updated.Set("id", "uuid")
+
+	assert.False(t, Diff(old, updated).IsEmpty())
+	assert.False(t, Diff(old, updated).OrderChanged())
+
+	updated.Set("name", "text")
+	updated.MoveToFront("name")
+	old.Set("name", "text")
+
+	assert.True(t, Diff(old, updated).OrderChanged())
+}
+
+func TestPatch_JSON(t *testing.T) {
+	old := NewMap[string, string]()
+	old.Set("id", "int")
+	old.Set("name", "text")
+	old.Set("age", "int")
+
+	updated := NewMap[string, string]()
+	updated.Set("email", "text")
+	updated.Set("id", "bigint")
+	updated.Set("name", "text")
+
+	data, err := json.Marshal(Diff(old, updated))
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `{
+		"added": [{"key": "email", "value": "text"}],
+		"removed": [{"key": "age", "value": "int"}],
+		"changed": [{"key": "id", "old": "int", "new": "bigint"}],
+		"order": ["email", "id", "name"]
+	}`, string(data))
+
+	var patch Patch[string, string]
+	require.NoError(t, json.Unmarshal(data, &patch))
+
+	patch.Apply(old)
+
+	assert.Equal(t, updated.Keys(), old.Keys())
+	assert.True(t, updated.Equal(old))
+}
@@ -0,0 +1,146 @@
+package gds
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"reflect"
+)
+
+var ErrMergeConflict = errors.New("merge conflict")
+
+// MergeStrategy resolves a key present in both merged maps.
+// Left is the value of the receiver, right is the value of the merged map.
+type MergeStrategy[K comparable, V any] func(key K, left, right V) (V, error)
+
+// MergeKeepLeft keeps values of the receiver.
+func MergeKeepLeft[K comparable, V any]() MergeStrategy[K, V] {
+	return func(_ K, left, _ V) (V, error) {
+		return left, nil
+	}
+}
+
+// MergeTakeRight takes values of the merged map.
+func MergeTakeRight[K comparable, V any]() MergeStrategy[K, V] {
+	return func(_ K, _, right V) (V, error) {
+		return right, nil
+	}
+}
+
+// MergeErrorOnConflict returns ErrMergeConflict when values are not deeply equal.
+func MergeErrorOnConflict[K comparable, V any]() MergeStrategy[K, V] {
+	return func(_ K, left, right V) (V, error) {
+		if !reflect.DeepEqual(left, right) {
+			return left, fmt.Errorf("%w: %v != %v", ErrMergeConflict, left, right)
+		}
+
+		return left, nil
+	}
+}
+
+// MergeWith resolves conflicts by resolver.
+func MergeWith[K comparable, V any](resolver func(key K, left, right V) V) MergeStrategy[K, V] {
+	return func(key K, left, right V) (V, error) {
+		return resolver(key, left, right), nil
+	}
+}
+
+// MergeNested merges values which are maps themselves, nested keys are resolved by strategy.
+// Strategy can be MergeNested too for deeper maps.
+func MergeNested[K, NK comparable, NV any](strategy MergeStrategy[NK, NV]) MergeStrategy[K, *Map[NK, NV]] {
+	return func(_ K, left, right *Map[NK, NV]) (*Map[NK, NV], error) {
+		if left == nil {
+			return right, nil
+		}
+
+		if right == nil {
+			return left, nil
+		}
+
+		return left.Merge(right, strategy)
+	}
+}
+
+// MergeDeep recursively merges values of type *Map[K, any] and map[K]any, other values are resolved by strategy.
+// Plain maps come from decoding nested objects of Map[K, any] from YAML or JSON.
+// Two plain maps are merged into a plain map, a plain map and *Map are merged into *Map.
+func MergeDeep[K comparable](strategy MergeStrategy[K, any]) MergeStrategy[K, any] {
+	var deep MergeStrategy[K, any]
+
+	deep = func(key K, left, right any) (any, error) {
+		switch l := left.(type) {
+		case *Map[K, any]:
+			switch r := right.(type) {
+			case *Map[K, any]:
+				if l != nil && r != nil {
+					return l.Merge(r, deep)
+				}
+			case map[K]any:
+				if l != nil {
+					return l.Merge(NewMapFrom(r), deep)
+				}
+			}
+		case map[K]any:
+			switch r := right.(type) {
+			case map[K]any:
+				return mergeDeepMaps(l, r, deep)
+			case *Map[K, any]:
+				if r != nil {
+					return NewMapFrom(l).Merge(r, deep)
+				}
+			}
+		}
+
+		return strategy(key, left, right)
+	}
+
+	return deep
+}
+
+// Merge returns a new map with entries of both maps, the receiver is not changed.
+// Keys of the receiver keep their positions, keys present only in that are appended in its order.
+// Keys present in both maps get the value returned by strategy.
+func (m *Map[K, V]) Merge(that *Map[K, V], strategy MergeStrategy[K, V]) (*Map[K, V], error) {
+	merged := m.Clone()
+
+	for k, right := range that.All() {
+		left, has := merged.Get(k)
+		if !has {
+			merged.Set(k, right)
+
+			continue
+		}
+
+		val, err := strategy(k, left, right)
+		if err != nil {
+			return nil, fmt.Errorf("merge key %v: %w", k, err)
+		}
+
+		merged.Set(k, val)
+	}
+
+	return merged, nil
+}
+
+func mergeDeepMaps[K comparable](left, right map[K]any, deep MergeStrategy[K, any]) (map[K]any, error) {
+	merged := make(map[K]any, len(left)+len(right))
+	maps.Copy(merged, left)
+
+	for k, r := range right {
+		l, has := merged[k]
+		if !has {
+			merged[k] = r
+
+			continue
+		}
+
+		val, err := deep(k, l, r)
+		if err != nil {
+			return nil, fmt.Errorf("merge key %v: %w", k, err)
+		}
+
+		merged[k] = val
+	}
+
+	return merged, nil
+}
@@ -0,0 +1,218 @@
+package gds
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestMap_Merge(t *testing.T) {
+	cases := []struct {
+		Title        string
+		Strategy     MergeStrategy[string, int]
+		ExpectedKeys []string
+		ExpectedList []int
+		ExpectedErr  error
+	}{
+		{
+			Title:        "keep left",
+			Strategy:     MergeKeepLeft[string, int](),
+			ExpectedKeys: []string{"a", "b", "c", "d"},
+			ExpectedList: []int{1, 2, 3, 4},
+		},
+		{
+			Title:        "take right",
+			Strategy:     MergeTakeRight[string, int](),
+			ExpectedKeys: []string{"a", "b", "c", "d"},
+			ExpectedList: []int{1, 20, 3, 4},
+		},
+		{
+			Title: "custom resolver",
+			Strategy: MergeWith(func(_ string, left, right int) int {
+				return left + right
+			}),
+			ExpectedKeys: []string{"a", "b", "c", "d"},
+			ExpectedList: []int{1, 22, 6, 4},
+		},
+		{
+			Title:       "error on conflict",
+			Strategy:    MergeErrorOnConflict[string, int](),
+			ExpectedErr: ErrMergeConflict,
+		},
+	}
+
+	for _, tCase := range cases {
+		t.Run(tCase.Title, func(t *testing.T) {
+			left := NewMap[string, int]()
+			left.Set("a", 1)
+			left.Set("b", 2)
+			left.Set("c", 3)
+
+			right := NewMap[string, int]()
+			right.Set("d", 4)
+			right.Set("b", 20)
+			right.Set("c", 3)
+
+			got, err := left.Merge(right, tCase.Strategy)
+			if tCase.ExpectedErr != nil {
+				require.ErrorIs(t, err, tCase.ExpectedErr)
+				assert.Contains(t, err.Error(), "merge key b")
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tCase.ExpectedKeys, got.Keys())
+			assert.Equal(t, tCase.ExpectedList, got.List())
+
+			assert.Equal(t, []int{1, 2, 3}, left.List())
+			assert.Equal(t, []int{4, 20, 3}, right.List())
+		})
+	}
+
+	t.Run("error on conflict without conflicts", func(t *testing.T) {
+		left := NewMap[string, int]()
+		left.Set("a", 1)
+		left.Set("b", 2)
+		left.Set("c", 3)
+
+		right := NewMap[string, int]()
+		right.Set("d", 4)
+		right.Set("c", 3)
+
+		got, err := left.Merge(right, MergeErrorOnConflict[string, int]())
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"a", "b", "c", "d"}, got.Keys())
+	})
+}
+
+func TestMap_MergeNested(t *testing.T) {
+	var base, override struct {
+		Services *Map[string, *Map[string, string]] `yaml:"services"`
+	}
+
+	require.NoError(t, yaml.Unmarshal([]byte(`
+services:
+  api:
+    image: api:1
+    port: "80"
+  db:
+    image: postgres
+`), &base))
+
+	require.NoError(t, yaml.Unmarshal([]byte(`
+services:
+  api:
+    port: "8080"
+    replicas: "2"
+  cache:
+    image: redis
+`), &override))
+
+	got, err := base.Services.Merge(
+		override.Services,
+		MergeNested[string](MergeTakeRight[string, string]()),
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"api", "db", "cache"}, got.Keys())
+
+	api, _ := got.Get("api")
+	assert.Equal(t, []string{"image", "port", "replicas"}, api.Keys())
+	assert.Equal(t, []string{"api:1", "8080", "2"}, api.List())
+
+	baseAPI, _ := base.Services.Get("api")
+	assert.Equal(t, []string{"api:1", "80"}, baseAPI.List())
+}
+
+func TestMap_MergeDeep(t *testing.T) {
+	var base, override *Map[string, any]
+
+	require.NoError(t, yaml.Unmarshal([]byte(`
+name: app
+db:
+  host: a
+  port: 1
+  pool:
+    size: 10
+`), &base))
+
+	require.NoError(t, yaml.Unmarshal([]byte(`
+name: app2
+db:
+  port: 2
+  pool:
+    timeout: 1s
+`), &override))
+
+	t.Run("take right", func(t *testing.T) {
+		got, err := base.Merge(override, MergeDeep(MergeTakeRight[string, any]()))
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"name", "db"}, got.Keys())
+
+		name, _ := got.Get("name")
+		assert.Equal(t, "app2", name)
+
+		db, _ := got.Get("db")
+		assert.Equal(t, map[string]any{
+			"host": "a",
+			"port": 2,
+			"pool": map[string]any{
+				"size":    10,
+				"timeout": "1s",
+			},
+		}, db)
+
+		baseDB, _ := base.Get("db")
+		assert.Equal(t, map[string]any{
+			"host": "a",
+			"port": 1,
+			"pool": map[string]any{
+				"size": 10,
+			},
+		}, baseDB)
+	})
+
+	t.Run("ordered and plain maps", func(t *testing.T) {
+		db := NewMap[string, any]()
+		db.Set("user", "root")
+		db.Set("port", 3)
+
+		ordered := NewMap[string, any]()
+		ordered.Set("db", db)
+
+		got, err := base.Merge(ordered, MergeDeep(MergeTakeRight[string, any]()))
+		require.NoError(t, err)
+
+		mergedDB, _ := got.Get("db")
+		mergedDBMap, ok := mergedDB.(*Map[string, any])
+		require.True(t, ok)
+
+		assert.Equal(t, map[string]any{
+			"host": "a",
+			"port": 3,
+			"user": "root",
+			"pool": map[string]any{
+				"size": 10,
+			},
+		}, mergedDBMap.ToMap())
+	})
+
+	t.Run("error on conflict", func(t *testing.T) {
+		_, err := base.Merge(override, MergeDeep(MergeErrorOnConflict[string, any]()))
+		require.ErrorIs(t, err, ErrMergeConflict)
+		assert.Contains(t, err.Error(), "merge key name")
+	})
+
+	t.Run("error on nested conflict", func(t *testing.T) {
+		override.Set("name", "app")
+
+		_, err := base.Merge(override, MergeDeep(MergeErrorOnConflict[string, any]()))
+		require.ErrorIs(t, err, ErrMergeConflict)
+		assert.Contains(t, err.Error(), "merge key db: merge key port")
+	})
+}
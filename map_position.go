@@ -0,0 +1,152 @@
+package gds
+
+import "slices"
+
+// At returns the entry at position i in insertion order.
+// It returns zero values when i is out of range.
+// It is O(1) unless the map has deleted entries which were not compacted yet.
+func (m *Map[K, V]) At(i int) (K, V) {
+	var nilKey K
+
+	if i < 0 || i >= m.Len() {
+		return nilKey, m.nilVal
+	}
+
+	if m.tombstones == 0 {
+		return m.entries[i].key, m.entries[i].value
+	}
+
+	for k, v := range m.All() {
+		if i == 0 {
+			return k, v
+		}
+		i--
+	}
+
+	return nilKey, m.nilVal
+}
+
+// IndexOf returns position of the key in insertion order or -1 when the key is absent.
+func (m *Map[K, V]) IndexOf(key K) int {
+	id, has := m.keyIndex[key]
+	if !has {
+		return -1
+	}
+
+	if m.tombstones == 0 {
+		return id
+	}
+
+	pos := 0
+	for i := m.head; i < id; i++ {
+		if !m.entries[i].deleted {
+			pos++
+		}
+	}
+
+	return pos
+}
+
+// InsertAt puts the entry at position i, shifting later entries.
+// An existing key is moved to the position. Position is clamped to [0, Len()].
+func (m *Map[K, V]) InsertAt(i int, key K, val V) {
+	m.lazyInit()
+	m.compact()
+	m.removeCompacted(key)
+
+	i = min(max(i, 0), len(m.entries))
+
+	m.entries = slices.Insert(m.entries, i, mapEntry[K, V]{
+		key:   key,
+		value: val,
+	})
+	m.reindex(i)
+}
+
+// InsertBefore puts the entry right before anchor. It returns false when anchor is absent.
+func (m *Map[K, V]) InsertBefore(anchor, key K, val V) bool {
+	return m.insertNear(anchor, key, val, 0)
+}
+
+// InsertAfter puts the entry right after anchor. It returns false when anchor is absent.
+func (m *Map[K, V]) InsertAfter(anchor, key K, val V) bool {
+	return m.insertNear(anchor, key, val, 1)
+}
+
+// MoveToFront moves the key to the first position. It returns false when the key is absent.
+func (m *Map[K, V]) MoveToFront(key K) bool {
+	val, has := m.Get(key)
+	if !has {
+		return false
+	}
+
+	m.InsertAt(0, key, val)
+
+	return true
+}
+
+// MoveToBack moves the key to the last position in amortized O(1).
+// It returns false when the key is absent.
+func (m *Map[K, V]) MoveToBack(key K) bool {
+	val, has := m.Get(key)
+	if !has {
+		return false
+	}
+
+	m.Delete(key)
+	m.Set(key, val)
+
+	return true
+}
+
+// Swap exchanges positions of two keys. It returns false when any of the keys is absent.
+func (m *Map[K, V]) Swap(key1, key2 K) bool {
+	id1, has1 := m.keyIndex[key1]
+	id2, has2 := m.keyIndex[key2]
+
+	if !has1 || !has2 {
+		return false
+	}
+
+	m.entries[id1], m.entries[id2] = m.entries[id2], m.entries[id1]
+	m.keyIndex[key1], m.keyIndex[key2] = id2, id1
+
+	return true
+}
+
+func (m *Map[K, V]) insertNear(anchor, key K, val V, offset int) bool {
+	if !m.Has(anchor) {
+		return false
+	}
+
+	if key == anchor {
+		m.Set(key, val)
+
+		return true
+	}
+
+	m.compact()
+	m.removeCompacted(key)
+	m.InsertAt(m.keyIndex[anchor]+offset, key, val)
+
+	return true
+}
+
+// removeCompacted removes the key from entries without leaving a tombstone, entries must be compacted.
+func (m *Map[K, V]) removeCompacted(key K) {
+	id, has := m.keyIndex[key]
+	if !has {
+		return
+	}
+
+	delete(m.keyIndex, key)
+
+	m.entries = slices.Delete(m.entries, id, id+1)
+	m.reindex(id)
+}
+
+func (m *Map[K, V]) reindex(from int) {
+	for i := from; i < len(m.entries); i++ {
+		m.keyIndex[m.entries[i].key] = i
+	}
+}
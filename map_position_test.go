@@ -0,0 +1,224 @@
+package gds
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMap_At(t *testing.T) {
+	m := NewMap[string, int]()
+
+	m.Set("id", 1)
+	m.Set("tmp", 0)
+	m.Set("name", 2)
+	m.Set("email", 3)
+	m.Delete("tmp")
+
+	cases := []struct {
+		Index       int
+		ExpectedKey string
+		ExpectedVal int
+	}{
+		{Index: 0, ExpectedKey: "id", ExpectedVal: 1},
+		{Index: 1, ExpectedKey: "name", ExpectedVal: 2},
+		{Index: 2, ExpectedKey: "email", ExpectedVal: 3},
+		{Index: 3},
+		{Index: -1},
+	}
+
+	for _, tCase := range cases {
+		key, val := m.At(tCase.Index)
+
+		assert.Equal(t, tCase.ExpectedKey, key)
+		assert.Equal(t, tCase.ExpectedVal, val)
+	}
+}
+
+func TestMap_IndexOf(t *testing.T) {
+	m := NewMap[string, int]()
+
+	m.Set("id", 1)
+	m.Set("tmp", 0)
+	m.Set("name", 2)
+	m.Set("email", 3)
+	m.Delete("tmp")
+
+	assert.Equal(t, 0, m.IndexOf("id"))
+	assert.Equal(t, 1, m.IndexOf("name"))
+	assert.Equal(t, 2, m.IndexOf("email"))
+	assert.Equal(t, -1, m.IndexOf("tmp"))
+	assert.Equal(t, -1, m.IndexOf("unknown"))
+}
+
+func TestMap_InsertAt(t *testing.T) {
+	cases := []struct {
+		Title        string
+		Index        int
+		Key          string
+		ExpectedKeys []string
+	}{
+		{
+			Title:        "insert at front",
+			Index:        0,
+			Key:          "x",
+			ExpectedKeys: []string{"x", "id", "name", "email"},
+		},
+		{
+			Title:        "insert in middle",
+			Index:        2,
+			Key:          "x",
+			ExpectedKeys: []string{"id", "name", "x", "email"},
+		},
+		{
+			Title:        "insert after end",
+			Index:        10,
+			Key:          "x",
+			ExpectedKeys: []string{"id", "name", "email", "x"},
+		},
+		{
+			Title:        "insert before start",
+			Index:        -5,
+			Key:          "x",
+			ExpectedKeys: []string{"x", "id", "name", "email"},
+		},
+		{
+			Title:        "move existing key",
+			Index:        2,
+			Key:          "id",
+			ExpectedKeys: []string{"name", "email", "id"},
+		},
+	}
+
+	for _, tCase := range cases {
+		t.Run(tCase.Title, func(t *testing.T) {
+			m := NewMap[string, int]()
+
+			m.Set("id", 1)
+			m.Set("name", 2)
+			m.Set("email", 3)
+
+			m.InsertAt(tCase.Index, tCase.Key, 10)
+
+			assert.Equal(t, tCase.ExpectedKeys, m.Keys())
+			assert.Equal(t, len(tCase.ExpectedKeys), m.Len())
+
+			got, _ := m.Get(tCase.Key)
+			assert.Equal(t, 10, got)
+
+			for i, key := range tCase.ExpectedKeys {
+				assert.Equal(t, i, m.IndexOf(key))
+			}
+		})
+	}
+}
+
+func TestMap_InsertBeforeAfter(t *testing.T) {
+	cases := []struct {
+		Title        string
+		Insert       func(m *Map[string, int]) bool
+		Expected     bool
+		ExpectedKeys []string
+		ExpectedList []int
+	}{
+		{
+			Title: "insert after column",
+			Insert: func(m *Map[string, int]) bool {
+				return m.InsertAfter("name", "created_at", 4) && m.InsertAfter("email", "updated_at", 5)
+			},
+			Expected:     true,
+			ExpectedKeys: []string{"id", "name", "created_at", "email", "updated_at"},
+			ExpectedList: []int{1, 2, 4, 3, 5},
+		},
+		{
+			Title: "insert before column",
+			Insert: func(m *Map[string, int]) bool {
+				return m.InsertBefore("id", "uuid", 4) && m.InsertBefore("email", "phone", 5)
+			},
+			Expected:     true,
+			ExpectedKeys: []string{"uuid", "id", "name", "phone", "email"},
+			ExpectedList: []int{4, 1, 2, 5, 3},
+		},
+		{
+			Title: "move existing key",
+			Insert: func(m *Map[string, int]) bool {
+				return m.InsertAfter("email", "id", 7) && m.InsertBefore("name", "email", 8)
+			},
+			Expected:     true,
+			ExpectedKeys: []string{"email", "name", "id"},
+			ExpectedList: []int{8, 2, 7},
+		},
+		{
+			Title: "anchor is the key",
+			Insert: func(m *Map[string, int]) bool {
+				return m.InsertAfter("name", "name", 7)
+			},
+			Expected:     true,
+			ExpectedKeys: []string{"id", "name", "email"},
+			ExpectedList: []int{1, 7, 3},
+		},
+		{
+			Title: "unknown anchor",
+			Insert: func(m *Map[string, int]) bool {
+				return m.InsertAfter("x", "y", 1) || m.InsertBefore("x", "y", 1)
+			},
+			Expected:     false,
+			ExpectedKeys: []string{"id", "name", "email"},
+			ExpectedList: []int{1, 2, 3},
+		},
+	}
+
+	for _, tCase := range cases {
+		t.Run(tCase.Title, func(t *testing.T) {
+			m := NewMap[string, int]()
+
+			m.Set("id", 1)
+			m.Set("name", 2)
+			m.Set("email", 3)
+
+			assert.Equal(t, tCase.Expected, tCase.Insert(m))
+			assert.Equal(t, tCase.ExpectedKeys, m.Keys())
+			assert.Equal(t, tCase.ExpectedList, m.List())
+		})
+	}
+}
+
+func TestMap_Move(t *testing.T) {
+	m := NewMap[string, int]()
+
+	m.Set("id", 1)
+	m.Set("name", 2)
+	m.Set("email", 3)
+
+	assert.True(t, m.MoveToFront("email"))
+	assert.Equal(t, []string{"email", "id", "name"}, m.Keys())
+
+	assert.True(t, m.MoveToBack("email"))
+	assert.Equal(t, []string{"id", "name", "email"}, m.Keys())
+
+	assert.True(t, m.MoveToBack("id"))
+	assert.Equal(t, []string{"name", "email", "id"}, m.Keys())
+
+	first, _ := m.At(0)
+	assert.Equal(t, "name", first)
+
+	assert.False(t, m.MoveToFront("x"))
+	assert.False(t, m.MoveToBack("x"))
+}
+
+func TestMap_Swap(t *testing.T) {
+	m := NewMap[string, int]()
+
+	m.Set("id", 1)
+	m.Set("name", 2)
+	m.Set("email", 3)
+
+	assert.True(t, m.Swap("id", "email"))
+	assert.Equal(t, []string{"email", "name", "id"}, m.Keys())
+	assert.Equal(t, []int{3, 2, 1}, m.List())
+
+	got, _ := m.Get("id")
+	assert.Equal(t, 1, got)
+
+	assert.False(t, m.Swap("id", "x"))
+}
@@ -0,0 +1,55 @@
+package gds
+
+import "slices"
+
+// SortByKey reorders entries by keys.
+func (m *Map[K, V]) SortByKey(cmp func(a, b K) int) {
+	m.SortFunc(func(a, b Entry[K, V]) int {
+		return cmp(a.Key, b.Key)
+	})
+}
+
+// SortByValue reorders entries by values.
+func (m *Map[K, V]) SortByValue(cmp func(a, b V) int) {
+	m.SortFunc(func(a, b Entry[K, V]) int {
+		return cmp(a.Value, b.Value)
+	})
+}
+
+// SortFunc reorders entries by cmp. Sorting is stable: equal entries keep their relative order.
+func (m *Map[K, V]) SortFunc(cmp func(a, b Entry[K, V]) int) {
+	m.compact()
+
+	slices.SortStableFunc(m.entries, func(a, b mapEntry[K, V]) int {
+		return cmp(
+			Entry[K, V]{Key: a.key, Value: a.value},
+			Entry[K, V]{Key: b.key, Value: b.value},
+		)
+	})
+
+	m.reindex(0)
+}
+
+// SortedByKey returns a copy of the map sorted by keys.
+func (m *Map[K, V]) SortedByKey(cmp func(a, b K) int) *Map[K, V] {
+	sorted := m.Clone()
+	sorted.SortByKey(cmp)
+
+	return sorted
+}
+
+// SortedByValue returns a copy of the map sorted by values.
+func (m *Map[K, V]) SortedByValue(cmp func(a, b V) int) *Map[K, V] {
+	sorted := m.Clone()
+	sorted.SortByValue(cmp)
+
+	return sorted
+}
+
+// SortedFunc returns a copy of the map sorted by cmp.
+func (m *Map[K, V]) SortedFunc(cmp func(a, b Entry[K, V]) int) *Map[K, V] {
+	sorted := m.Clone()
+	sorted.SortFunc(cmp)
+
+	return sorted
+}
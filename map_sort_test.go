@@ -0,0 +1,97 @@
+package gds
+
+import (
+	"cmp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMap_SortByKey(t *testing.T) {
+	m := NewMap[string, int]()
+
+	m.Set("id", 2)
+	m.Set("name", 1)
+	m.Set("deleted", 0)
+	m.Set("created_at", 2)
+	m.Delete("deleted")
+
+	m.SortByKey(strings.Compare)
+
+	assert.Equal(t, []string{"created_at", "id", "name"}, m.Keys())
+	assert.Equal(t, []int{2, 2, 1}, m.List())
+
+	got, ok := m.Get("name")
+	assert.True(t, ok)
+	assert.Equal(t, 1, got)
+
+	m.Set("b", 3)
+	m.Delete("created_at")
+
+	assert.Equal(t, []string{"id", "name", "b"}, m.Keys())
+}
+
+func TestMap_Sort(t *testing.T) {
+	cases := []struct {
+		Title        string
+		Sort         func(m *Map[string, int])
+		ExpectedKeys []string
+	}{
+		{
+			Title: "by value",
+			Sort: func(m *Map[string, int]) {
+				m.SortByValue(cmp.Compare[int])
+			},
+			ExpectedKeys: []string{"name", "age", "id", "created_at"},
+		},
+		{
+			Title: "by func",
+			Sort: func(m *Map[string, int]) {
+				m.SortFunc(func(a, b Entry[string, int]) int {
+					if c := cmp.Compare(b.Value, a.Value); c != 0 {
+						return c
+					}
+
+					return strings.Compare(a.Key, b.Key)
+				})
+			},
+			ExpectedKeys: []string{"created_at", "id", "age", "name"},
+		},
+	}
+
+	for _, tCase := range cases {
+		t.Run(tCase.Title, func(t *testing.T) {
+			m := NewMap[string, int]()
+
+			m.Set("id", 2)
+			m.Set("name", 1)
+			m.Set("created_at", 2)
+			m.Set("age", 1)
+
+			tCase.Sort(m)
+
+			assert.Equal(t, tCase.ExpectedKeys, m.Keys())
+		})
+	}
+}
+
+func TestMap_Sorted(t *testing.T) {
+	m := NewMap[string, int]()
+
+	m.Set("id", 2)
+	m.Set("name", 1)
+	m.Set("created_at", 2)
+	m.Set("age", 1)
+
+	byKey := m.SortedByKey(strings.Compare)
+	byValue := m.SortedByValue(cmp.Compare[int])
+	byFunc := m.SortedFunc(func(a, b Entry[string, int]) int {
+		return cmp.Compare(len(a.Key), len(b.Key))
+	})
+
+	assert.Equal(t, []string{"id", "name", "created_at", "age"}, m.Keys())
+	assert.Equal(t, []string{"age", "created_at", "id", "name"}, byKey.Keys())
+	assert.Equal(t, []string{"name", "age", "id", "created_at"}, byValue.Keys())
+	assert.Equal(t, []string{"id", "age", "name", "created_at"}, byFunc.Keys())
+}
@@ -1,8 +1,13 @@
 package gds
 
 import (
+	"encoding/json"
+	"errors"
 	"gopkg.in/yaml.v3"
 	"os"
+	"slices"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -197,6 +202,84 @@ func TestMap_DeleteMany(t *testing.T) {
 	})
 }
 
+func TestMap_DeleteKeepsConsistency(t *testing.T) {
+	t.Run("delete unknown keys", func(t *testing.T) {
+		m := NewMap[string, int]()
+		m.Set("a", 1)
+
+		m.Delete("b")
+		m.DeleteMany([]string{"c", "d"})
+
+		assert.Equal(t, []string{"a"}, m.Keys())
+	})
+
+	t.Run("set after delete appends key", func(t *testing.T) {
+		m := NewMap[string, int]()
+
+		m.Set("a", 1)
+		m.Set("b", 2)
+		m.Set("c", 3)
+
+		m.Delete("a")
+		m.Set("a", 4)
+
+		assert.Equal(t, []string{"b", "c", "a"}, m.Keys())
+		assert.Equal(t, []int{2, 3, 4}, m.List())
+		assert.Equal(t, 2, m.First())
+	})
+
+	t.Run("delete all", func(t *testing.T) {
+		m := NewMap[string, int]()
+
+		m.Set("a", 1)
+		m.Set("b", 2)
+
+		m.DeleteMany([]string{"b", "a"})
+
+		assert.True(t, m.IsEmpty())
+		assert.Equal(t, 0, m.First())
+		assert.Equal(t, []string{}, m.Keys())
+		assert.Equal(t, map[string]int{}, m.ToMap())
+	})
+
+	t.Run("matches built-in map", func(t *testing.T) {
+		m := NewMap[int, int]()
+		expectedKeys := []int{}
+		expected := map[int]int{}
+
+		for i := 0; i < 1000; i++ {
+			key := (i * 7) % 61
+
+			if i%3 == 0 {
+				m.Delete(key)
+				delete(expected, key)
+				expectedKeys = slices.DeleteFunc(expectedKeys, func(k int) bool {
+					return k == key
+				})
+
+				continue
+			}
+
+			if _, has := expected[key]; !has {
+				expectedKeys = append(expectedKeys, key)
+			}
+
+			m.Set(key, i)
+			expected[key] = i
+		}
+
+		assert.Equal(t, expectedKeys, m.Keys())
+		assert.Equal(t, expected, m.ToMap())
+		assert.Equal(t, len(expected), m.Len())
+
+		for k, v := range expected {
+			got, ok := m.Get(k)
+			require.True(t, ok)
+			assert.Equal(t, v, got)
+		}
+	})
+}
+
 func TestMap_Get(t *testing.T) {
 	t.Run("get empty string", func(t *testing.T) {
 		m := NewMap[string, string]()
@@ -243,6 +326,129 @@ func TestMap_Equal(t *testing.T) {
 	})
 }
 
+func TestMap_Walk(t *testing.T) {
+	m := NewMap[string, int]()
+
+	for i, key := range []string{"k", "c", "x", "a", "m", "b"} {
+		m.Set(key, i)
+	}
+
+	t.Run("insertion order", func(t *testing.T) {
+		keys := []string{}
+
+		m.Walk(func(key string, _ int) bool {
+			keys = append(keys, key)
+			return true
+		})
+
+		assert.Equal(t, []string{"k", "c", "x", "a", "m", "b"}, keys)
+	})
+
+	t.Run("stop walk", func(t *testing.T) {
+		keys := []string{}
+
+		m.Walk(func(key string, val int) bool {
+			keys = append(keys, key)
+			return val < 2
+		})
+
+		assert.Equal(t, []string{"k", "c", "x"}, keys)
+	})
+
+	t.Run("reverse", func(t *testing.T) {
+		keys := []string{}
+
+		m.WalkReverse(func(key string, val int) bool {
+			keys = append(keys, key)
+			return val > 3
+		})
+
+		assert.Equal(t, []string{"b", "m", "a"}, keys)
+	})
+
+	t.Run("walk with error", func(t *testing.T) {
+		keys := []string{}
+		expectedErr := errors.New("stop")
+
+		err := m.WalkErr(func(key string, val int) error {
+			keys = append(keys, key)
+			if val == 3 {
+				return expectedErr
+			}
+			return nil
+		})
+
+		assert.ErrorIs(t, err, expectedErr)
+		assert.Equal(t, []string{"k", "c", "x", "a"}, keys)
+	})
+
+	t.Run("walk without error", func(t *testing.T) {
+		count := 0
+
+		err := m.WalkErr(func(_ string, _ int) error {
+			count++
+			return nil
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, m.Len(), count)
+	})
+}
+
+func TestMap_Clone(t *testing.T) {
+	t.Run("clone is independent", func(t *testing.T) {
+		m := NewMap[string, int]()
+
+		m.Set("a", 1)
+		m.Set("b", 2)
+		m.Set("c", 3)
+		m.Delete("a")
+
+		cloned := m.Clone()
+
+		cloned.Set("b", 20)
+		cloned.Set("d", 4)
+		cloned.Delete("c")
+
+		assert.Equal(t, []string{"b", "c"}, m.Keys())
+		assert.Equal(t, []int{2, 3}, m.List())
+		assert.Equal(t, map[string]int{"b": 2, "c": 3}, m.ToMap())
+
+		assert.Equal(t, []string{"b", "d"}, cloned.Keys())
+		assert.Equal(t, []int{20, 4}, cloned.List())
+		assert.Equal(t, map[string]int{"b": 20, "d": 4}, cloned.ToMap())
+	})
+
+	t.Run("clone of empty map", func(t *testing.T) {
+		cloned := NewMap[string, int]().Clone()
+
+		cloned.Set("a", 1)
+
+		assert.Equal(t, []string{"a"}, cloned.Keys())
+	})
+
+	t.Run("clone with cloner", func(t *testing.T) {
+		type item struct {
+			Value string
+		}
+
+		m := NewMap[string, *item]()
+		m.Set("a", &item{Value: "1"})
+
+		cloned := m.CloneFunc(func(v *item) *item {
+			c := *v
+			return &c
+		})
+
+		got, _ := cloned.Get("a")
+		got.Value = "2"
+
+		orig, _ := m.Get("a")
+		assert.Equal(t, "1", orig.Value)
+		assert.NotSame(t, orig, got)
+	})
+}
+
 func TestMap_UnmarshalYAML(t *testing.T) {
 	var cfg struct {
 		Values Map[string, int] `yaml:"values"`
@@ -261,3 +467,717 @@ func TestMap_UnmarshalYAML(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, *expected, cfg.Values)
 }
+
+type testJSONKey struct {
+	a, b string
+}
+
+func (k testJSONKey) MarshalText() ([]byte, error) {
+	return []byte(k.a + ":" + k.b), nil
+}
+
+func (k *testJSONKey) UnmarshalText(text []byte) error {
+	k.a, k.b, _ = strings.Cut(string(text), ":")
+	return nil
+}
+
+func TestMap_MarshalJSON(t *testing.T) {
+	t.Run("keeps insertion order", func(t *testing.T) {
+		m := NewMap[string, int]()
+
+		m.Set("z", 1)
+		m.Set("a", 2)
+		m.Set("m", 3)
+
+		got, err := json.Marshal(m)
+		require.NoError(t, err)
+
+		assert.Equal(t, `{"z":1,"a":2,"m":3}`, string(got))
+	})
+
+	t.Run("empty map", func(t *testing.T) {
+		got, err := json.Marshal(NewMap[string, int]())
+		require.NoError(t, err)
+
+		assert.Equal(t, `{}`, string(got))
+	})
+
+	t.Run("int keys", func(t *testing.T) {
+		m := NewMap[int, string]()
+
+		m.Set(10, "a")
+		m.Set(-2, "b")
+
+		got, err := json.Marshal(m)
+		require.NoError(t, err)
+
+		assert.Equal(t, `{"10":"a","-2":"b"}`, string(got))
+	})
+
+	t.Run("text marshaler keys", func(t *testing.T) {
+		m := NewMap[testJSONKey, int]()
+
+		m.Set(testJSONKey{a: "x", b: "y"}, 1)
+
+		got, err := json.Marshal(m)
+		require.NoError(t, err)
+
+		assert.Equal(t, `{"x:y":1}`, string(got))
+	})
+
+	t.Run("nested map", func(t *testing.T) {
+		inner := NewMap[string, []string]()
+		inner.Set("b", []string{"1", "2"})
+		inner.Set("a", nil)
+
+		m := NewMap[string, *Map[string, []string]]()
+		m.Set("inner", inner)
+
+		got, err := json.Marshal(m)
+		require.NoError(t, err)
+
+		assert.Equal(t, `{"inner":{"b":["1","2"],"a":null}}`, string(got))
+	})
+
+	t.Run("unsupported key", func(t *testing.T) {
+		m := NewMap[float64, int]()
+		m.Set(1.5, 1)
+
+		_, err := json.Marshal(m)
+		require.Error(t, err)
+	})
+}
+
+func TestMap_UnmarshalJSON(t *testing.T) {
+	t.Run("keeps document order", func(t *testing.T) {
+		var cfg struct {
+			Values Map[string, int] `json:"values"`
+		}
+
+		err := json.Unmarshal([]byte(`{"values":{"k2":6,"k1":5,"k3":7}}`), &cfg)
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"k2", "k1", "k3"}, cfg.Values.Keys())
+		assert.Equal(t, []int{6, 5, 7}, cfg.Values.List())
+	})
+
+	t.Run("nested values", func(t *testing.T) {
+		m := NewMap[string, *Map[string, []string]]()
+
+		err := json.Unmarshal([]byte(`{"a":{"y":["1","2"],"x":[]}}`), m)
+		require.NoError(t, err)
+
+		inner, ok := m.Get("a")
+		require.True(t, ok)
+
+		assert.Equal(t, []string{"y", "x"}, inner.Keys())
+		assert.Equal(t, [][]string{{"1", "2"}, {}}, inner.List())
+	})
+
+	t.Run("int keys", func(t *testing.T) {
+		m := NewMap[uint8, string]()
+
+		err := json.Unmarshal([]byte(`{"3":"c","1":"a"}`), m)
+		require.NoError(t, err)
+
+		assert.Equal(t, []uint8{3, 1}, m.Keys())
+	})
+
+	t.Run("text unmarshaler keys", func(t *testing.T) {
+		m := NewMap[testJSONKey, int]()
+
+		err := json.Unmarshal([]byte(`{"x:y":1}`), m)
+		require.NoError(t, err)
+
+		assert.Equal(t, []testJSONKey{{a: "x", b: "y"}}, m.Keys())
+	})
+
+	t.Run("null", func(t *testing.T) {
+		m := NewMap[string, int]()
+
+		err := json.Unmarshal([]byte(`null`), m)
+		require.NoError(t, err)
+
+		assert.True(t, m.IsEmpty())
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		m := NewMap[string, int]()
+		m.Set("b", 1)
+		m.Set("a", 2)
+
+		data, err := json.Marshal(m)
+		require.NoError(t, err)
+
+		got := NewMap[string, int]()
+		require.NoError(t, json.Unmarshal(data, got))
+
+		assert.Equal(t, m.Keys(), got.Keys())
+		assert.Equal(t, m.List(), got.List())
+	})
+
+	t.Run("not an object", func(t *testing.T) {
+		m := NewMap[string, int]()
+
+		err := json.Unmarshal([]byte(`[1,2]`), m)
+		require.Error(t, err)
+	})
+
+	t.Run("invalid key", func(t *testing.T) {
+		m := NewMap[int, int]()
+
+		err := json.Unmarshal([]byte(`{"a":1}`), m)
+		require.Error(t, err)
+	})
+}
+
+func TestMap_UnmarshalYAML_NestedValues(t *testing.T) {
+	t.Run("sequence values", func(t *testing.T) {
+		m := NewMap[string, []string]()
+
+		err := yaml.Unmarshal([]byte(`
+b: [x, y]
+a:
+  - z
+`), m)
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"b", "a"}, m.Keys())
+		assert.Equal(t, [][]string{{"x", "y"}, {"z"}}, m.List())
+	})
+
+	t.Run("map values", func(t *testing.T) {
+		m := NewMap[string, *Map[string, int]]()
+
+		err := yaml.Unmarshal([]byte(`
+second:
+  k2: 2
+  k1: 1
+first:
+  k3: 3
+`), m)
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"second", "first"}, m.Keys())
+
+		second, ok := m.Get("second")
+		require.True(t, ok)
+		assert.Equal(t, []string{"k2", "k1"}, second.Keys())
+		assert.Equal(t, []int{2, 1}, second.List())
+	})
+}
+
+func TestMap_MarshalYAML(t *testing.T) {
+	t.Run("keeps insertion order", func(t *testing.T) {
+		m := NewMap[string, int]()
+
+		m.Set("z", 1)
+		m.Set("a", 2)
+
+		got, err := yaml.Marshal(m)
+		require.NoError(t, err)
+
+		assert.Equal(t, "z: 1\na: 2\n", string(got))
+	})
+
+	t.Run("nested values", func(t *testing.T) {
+		inner := NewMap[string, []string]()
+		inner.Set("y", []string{"1", "2"})
+		inner.Set("x", []string{"3"})
+
+		m := NewMap[string, *Map[string, []string]]()
+		m.Set("inner", inner)
+
+		got, err := yaml.Marshal(m)
+		require.NoError(t, err)
+
+		assert.Equal(t, `inner:
+    "y":
+        - "1"
+        - "2"
+    x:
+        - "3"
+`, string(got))
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		var cfg struct {
+			Values *Map[string, []int] `yaml:"values"`
+		}
+
+		src := `values:
+    b:
+        - 1
+        - 2
+    a: []
+`
+
+		require.NoError(t, yaml.Unmarshal([]byte(src), &cfg))
+
+		got, err := yaml.Marshal(cfg)
+		require.NoError(t, err)
+
+		assert.Equal(t, src, string(got))
+	})
+}
+
+func TestMap_Iterators(t *testing.T) {
+	m := NewMap[string, int]()
+
+	m.Set("c", 1)
+	m.Set("a", 2)
+	m.Set("b", 3)
+
+	t.Run("all", func(t *testing.T) {
+		keys := []string{}
+		values := []int{}
+
+		for k, v := range m.All() {
+			keys = append(keys, k)
+			values = append(values, v)
+		}
+
+		assert.Equal(t, []string{"c", "a", "b"}, keys)
+		assert.Equal(t, []int{1, 2, 3}, values)
+	})
+
+	t.Run("all with break", func(t *testing.T) {
+		keys := []string{}
+
+		for k := range m.All() {
+			keys = append(keys, k)
+			if k == "a" {
+				break
+			}
+		}
+
+		assert.Equal(t, []string{"c", "a"}, keys)
+	})
+
+	t.Run("backward", func(t *testing.T) {
+		keys := []string{}
+
+		for k := range m.Backward() {
+			keys = append(keys, k)
+		}
+
+		assert.Equal(t, []string{"b", "a", "c"}, keys)
+	})
+
+	t.Run("keys", func(t *testing.T) {
+		assert.Equal(t, []string{"c", "a", "b"}, slices.Collect(m.KeysSeq()))
+	})
+
+	t.Run("values", func(t *testing.T) {
+		assert.Equal(t, []int{1, 2, 3}, slices.Collect(m.Values()))
+	})
+}
+
+func TestNewMapFromSeq(t *testing.T) {
+	src := NewMap[string, int]()
+
+	src.Set("b", 1)
+	src.Set("a", 2)
+
+	got := NewMapFromSeq(src.Backward())
+
+	assert.Equal(t, []string{"a", "b"}, got.Keys())
+	assert.Equal(t, []int{2, 1}, got.List())
+}
+
+func benchmarkMapKeys(n int) []string {
+	keys := make([]string, n)
+	for i := range keys {
+		keys[i] = strconv.Itoa(i)
+	}
+
+	return keys
+}
+
+func benchmarkFilledMap(keys []string) *Map[string, int] {
+	m := NewMap[string, int]()
+	for i, key := range keys {
+		m.Set(key, i)
+	}
+
+	return m
+}
+
+func BenchmarkMap_Set(b *testing.B) {
+	keys := benchmarkMapKeys(10000)
+
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		benchmarkFilledMap(keys)
+	}
+}
+
+func BenchmarkMap_Delete(b *testing.B) {
+	for _, size := range []int{100, 1000, 10000} {
+		keys := benchmarkMapKeys(size)
+
+		b.Run(strconv.Itoa(size), func(b *testing.B) {
+			b.ReportAllocs()
+
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				m := benchmarkFilledMap(keys)
+				b.StartTimer()
+
+				for _, key := range keys {
+					m.Delete(key)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkMap_DeleteMany(b *testing.B) {
+	for _, size := range []int{100, 1000, 10000} {
+		keys := benchmarkMapKeys(size)
+		deleting := make([]string, 0, size/2)
+		for i := 0; i < size; i += 2 {
+			deleting = append(deleting, keys[i])
+		}
+
+		b.Run(strconv.Itoa(size), func(b *testing.B) {
+			b.ReportAllocs()
+
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				m := benchmarkFilledMap(keys)
+				b.StartTimer()
+
+				m.DeleteMany(deleting)
+			}
+		})
+	}
+}
+
+func TestMap_ZeroValue(t *testing.T) {
+	cases := []struct {
+		Title string
+		Check func(t *testing.T, m *Map[string, int])
+	}{
+		{
+			Title: "read methods",
+			Check: func(t *testing.T, m *Map[string, int]) {
+				_, ok := m.Get("a")
+				assert.False(t, ok)
+				assert.False(t, m.Has("a"))
+				assert.Equal(t, 0, m.First())
+				assert.Equal(t, 0, m.Len())
+				assert.True(t, m.IsEmpty())
+				assert.False(t, m.IsNotEmpty())
+				assert.Equal(t, []string{}, m.Keys())
+				assert.Equal(t, []int{}, m.List())
+				assert.Equal(t, map[string]int{}, m.ToMap())
+				assert.Empty(t, slices.Collect(m.KeysSeq()))
+				assert.Empty(t, slices.Collect(m.Values()))
+				assert.True(t, m.Equal(NewMap[string, int]()))
+			},
+		},
+		{
+			Title: "walk",
+			Check: func(t *testing.T, m *Map[string, int]) {
+				called := false
+				walker := func(string, int) bool {
+					called = true
+					return true
+				}
+
+				m.Walk(walker)
+				m.WalkReverse(walker)
+				require.NoError(t, m.WalkErr(func(string, int) error {
+					called = true
+					return nil
+				}))
+
+				for range m.All() {
+					called = true
+				}
+				for range m.Backward() {
+					called = true
+				}
+
+				assert.False(t, called)
+			},
+		},
+		{
+			Title: "set",
+			Check: func(t *testing.T, m *Map[string, int]) {
+				m.Set("a", 1)
+
+				assert.Equal(t, []string{"a"}, m.Keys())
+			},
+		},
+		{
+			Title: "delete",
+			Check: func(t *testing.T, m *Map[string, int]) {
+				m.Delete("a")
+				m.DeleteMany([]string{"a", "b"})
+				m.Set("a", 1)
+				m.Delete("a")
+
+				assert.True(t, m.IsEmpty())
+			},
+		},
+		{
+			Title: "keep",
+			Check: func(t *testing.T, m *Map[string, int]) {
+				m.Keep("a")
+				assert.True(t, m.CloneAndKeep("a").IsEmpty())
+
+				m.Set("a", 1)
+				assert.Equal(t, []string{"a"}, m.Keys())
+			},
+		},
+		{
+			Title: "clone",
+			Check: func(t *testing.T, m *Map[string, int]) {
+				cloned := m.Clone()
+				cloned.Set("a", 1)
+
+				clonedFunc := m.CloneFunc(func(v int) int {
+					return v
+				})
+				clonedFunc.Set("a", 1)
+
+				assert.True(t, m.IsEmpty())
+			},
+		},
+		{
+			Title: "sort",
+			Check: func(t *testing.T, m *Map[string, int]) {
+				m.SortByKey(strings.Compare)
+				m.SortByValue(func(a, b int) int {
+					return a - b
+				})
+				assert.True(t, m.SortedByKey(strings.Compare).IsEmpty())
+
+				m.Set("a", 1)
+				assert.Equal(t, []string{"a"}, m.Keys())
+			},
+		},
+		{
+			Title: "positions",
+			Check: func(t *testing.T, m *Map[string, int]) {
+				key, val := m.At(0)
+				assert.Equal(t, "", key)
+				assert.Equal(t, 0, val)
+				assert.Equal(t, -1, m.IndexOf("a"))
+				assert.False(t, m.InsertAfter("a", "b", 1))
+				assert.False(t, m.InsertBefore("a", "b", 1))
+				assert.False(t, m.MoveToFront("a"))
+				assert.False(t, m.MoveToBack("a"))
+				assert.False(t, m.Swap("a", "b"))
+
+				m.InsertAt(5, "a", 1)
+				assert.Equal(t, []string{"a"}, m.Keys())
+			},
+		},
+		{
+			Title: "merge",
+			Check: func(t *testing.T, m *Map[string, int]) {
+				that := NewMap[string, int]()
+				that.Set("a", 1)
+
+				merged, err := m.Merge(that, MergeKeepLeft[string, int]())
+				require.NoError(t, err)
+
+				assert.Equal(t, []string{"a"}, merged.Keys())
+				assert.True(t, m.IsEmpty())
+			},
+		},
+		{
+			Title: "marshal",
+			Check: func(t *testing.T, m *Map[string, int]) {
+				data, err := json.Marshal(m)
+				require.NoError(t, err)
+				assert.Equal(t, `{}`, string(data))
+
+				data, err = yaml.Marshal(m)
+				require.NoError(t, err)
+				assert.Equal(t, "{}\n", string(data))
+
+				value, err := m.Value()
+				require.NoError(t, err)
+				assert.Equal(t, []byte(`{}`), value)
+			},
+		},
+		{
+			Title: "unmarshal json",
+			Check: func(t *testing.T, m *Map[string, int]) {
+				require.NoError(t, json.Unmarshal([]byte(`{"a":1}`), m))
+
+				assert.Equal(t, []string{"a"}, m.Keys())
+			},
+		},
+		{
+			Title: "unmarshal yaml",
+			Check: func(t *testing.T, m *Map[string, int]) {
+				require.NoError(t, yaml.Unmarshal([]byte(`a: 1`), m))
+
+				assert.Equal(t, []string{"a"}, m.Keys())
+			},
+		},
+		{
+			Title: "scan",
+			Check: func(t *testing.T, m *Map[string, int]) {
+				require.NoError(t, m.Scan(`{"a":1}`))
+
+				assert.Equal(t, []string{"a"}, m.Keys())
+			},
+		},
+	}
+
+	for _, tCase := range cases {
+		t.Run(tCase.Title, func(t *testing.T) {
+			var m Map[string, int]
+
+			tCase.Check(t, &m)
+		})
+	}
+
+	t.Run("embedded in struct", func(t *testing.T) {
+		var table struct {
+			Columns Map[string, string]
+		}
+
+		table.Columns.Set("id", "int")
+		table.Columns.InsertBefore("id", "uuid", "uuid")
+
+		assert.Equal(t, []string{"uuid", "id"}, table.Columns.Keys())
+	})
+}
+
+func TestNewMapFromEntries(t *testing.T) {
+	m := NewMapFromEntries(
+		Entry[string, int]{Key: "z", Value: 1},
+		Entry[string, int]{Key: "a", Value: 2},
+		Entry[string, int]{Key: "z", Value: 3},
+	)
+
+	assert.Equal(t, []string{"z", "a"}, m.Keys())
+	assert.Equal(t, []int{3, 2}, m.List())
+	assert.Equal(t, []Entry[string, int]{
+		{Key: "z", Value: 3},
+		{Key: "a", Value: 2},
+	}, m.Entries())
+}
+
+func TestNewMapFromSortedMap(t *testing.T) {
+	src := map[string]int{"c": 3, "a": 1, "b": 2, "d": 4}
+
+	for i := 0; i < 10; i++ {
+		m := NewMapFromSortedMap(src, strings.Compare)
+
+		assert.Equal(t, []string{"a", "b", "c", "d"}, m.Keys())
+		assert.Equal(t, []int{1, 2, 3, 4}, m.List())
+	}
+}
+
+func TestMap_Entries(t *testing.T) {
+	m := NewMap[string, int]()
+	m.Set("b", 1)
+	m.Set("a", 2)
+	m.Delete("b")
+	m.Set("c", 3)
+
+	entries := m.Entries()
+	assert.Equal(t, []Entry[string, int]{
+		{Key: "a", Value: 2},
+		{Key: "c", Value: 3},
+	}, entries)
+
+	assert.Equal(t, m.Keys(), NewMapFromEntries(entries...).Keys())
+}
+
+func TestMap_DeleteDuringIteration(t *testing.T) {
+	cases := []struct {
+		Title    string
+		Iterate  func(m *Map[int, int], callback func(key int))
+		Expected []int
+	}{
+		{
+			Title: "all",
+			Iterate: func(m *Map[int, int], callback func(key int)) {
+				for k := range m.All() {
+					callback(k)
+				}
+			},
+			Expected: []int{8, 9},
+		},
+		{
+			Title: "backward",
+			Iterate: func(m *Map[int, int], callback func(key int)) {
+				for k := range m.Backward() {
+					callback(k)
+				}
+			},
+			Expected: []int{0, 1},
+		},
+		{
+			Title: "walk",
+			Iterate: func(m *Map[int, int], callback func(key int)) {
+				m.Walk(func(k int, _ int) bool {
+					callback(k)
+					return true
+				})
+			},
+			Expected: []int{8, 9},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Title, func(t *testing.T) {
+			m := NewMap[int, int]()
+			for i := 0; i < 10; i++ {
+				m.Set(i, i)
+			}
+
+			visited := 0
+
+			c.Iterate(m, func(key int) {
+				visited++
+
+				if !slices.Contains(c.Expected, key) {
+					m.Delete(key)
+				}
+			})
+
+			assert.Equal(t, 10, visited)
+			assert.Equal(t, c.Expected, m.Keys())
+
+			// compaction postponed by the iteration happens when it ends
+			assert.Equal(t, 0, m.tombstones)
+			assert.Len(t, m.entries, len(c.Expected))
+			assert.False(t, m.compactPostponed)
+		})
+	}
+}
+
+func TestMap_DeleteDuringNestedIteration(t *testing.T) {
+	m := NewMap[int, int]()
+	for i := 0; i < 10; i++ {
+		m.Set(i, i)
+	}
+
+	for k := range m.All() {
+		for range m.Backward() {
+			break
+		}
+
+		if k < 8 {
+			m.Delete(k)
+		}
+
+		// outer loop is still running
+		assert.Len(t, m.entries, 10)
+	}
+
+	assert.Equal(t, []int{8, 9}, m.Keys())
+	assert.Equal(t, 0, m.tombstones)
+	assert.Len(t, m.entries, 2)
+}
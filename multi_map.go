@@ -0,0 +1,167 @@
+package gds
+
+import (
+	"encoding/json"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"iter"
+	"slices"
+)
+
+// MultiMap maps ordered keys to ordered value lists.
+type MultiMap[K comparable, V comparable] struct {
+	lists      Map[K, []V]
+	valueCount int
+}
+
+func NewMultiMap[K comparable, V comparable]() *MultiMap[K, V] {
+	return &MultiMap[K, V]{
+		lists: *NewMap[K, []V](),
+	}
+}
+
+// Add appends values to the key list. New keys are added to the end.
+func (m *MultiMap[K, V]) Add(key K, values ...V) {
+	if len(values) == 0 {
+		return
+	}
+
+	list, _ := m.lists.Get(key)
+
+	m.lists.Set(key, append(list, values...))
+	m.valueCount += len(values)
+}
+
+// GetAll returns a copy of the key list.
+func (m *MultiMap[K, V]) GetAll(key K) []V {
+	list, _ := m.lists.Get(key)
+
+	return slices.Clone(list)
+}
+
+func (m *MultiMap[K, V]) Has(key K) bool {
+	return m.lists.Has(key)
+}
+
+func (m *MultiMap[K, V]) HasValue(key K, value V) bool {
+	list, _ := m.lists.Get(key)
+
+	return slices.Contains(list, value)
+}
+
+// Remove deletes the first occurrence of the value from the key list.
+// The key is deleted when its list becomes empty.
+func (m *MultiMap[K, V]) Remove(key K, value V) bool {
+	list, _ := m.lists.Get(key)
+
+	i := slices.Index(list, value)
+	if i < 0 {
+		return false
+	}
+
+	m.valueCount--
+
+	if len(list) == 1 {
+		m.lists.Delete(key)
+
+		return true
+	}
+
+	m.lists.Set(key, slices.Delete(list, i, i+1))
+
+	return true
+}
+
+// RemoveAll deletes the key and returns its values.
+func (m *MultiMap[K, V]) RemoveAll(key K) []V {
+	list, has := m.lists.Get(key)
+	if !has {
+		return nil
+	}
+
+	m.lists.Delete(key)
+	m.valueCount -= len(list)
+
+	return list
+}
+
+func (m *MultiMap[K, V]) KeyCount() int {
+	return m.lists.Len()
+}
+
+func (m *MultiMap[K, V]) ValueCount() int {
+	return m.valueCount
+}
+
+func (m *MultiMap[K, V]) IsEmpty() bool {
+	return m.lists.IsEmpty()
+}
+
+func (m *MultiMap[K, V]) Keys() []K {
+	return m.lists.Keys()
+}
+
+// All returns an iterator over key-value pairs, keys follow insertion order
+// and values of the same key follow their list order.
+func (m *MultiMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, list := range m.lists.All() {
+			for _, v := range list {
+				if !yield(k, v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Lists returns an iterator over keys and copies of their lists.
+func (m *MultiMap[K, V]) Lists() iter.Seq2[K, []V] {
+	return func(yield func(K, []V) bool) {
+		for k, list := range m.lists.All() {
+			if !yield(k, slices.Clone(list)) {
+				return
+			}
+		}
+	}
+}
+
+func (m *MultiMap[K, V]) MarshalJSON() ([]byte, error) {
+	return m.lists.MarshalJSON()
+}
+
+func (m *MultiMap[K, V]) UnmarshalJSON(data []byte) error {
+	lists := NewMap[K, []V]()
+
+	if err := json.Unmarshal(data, lists); err != nil {
+		return err
+	}
+
+	m.addLists(lists)
+
+	return nil
+}
+
+func (m *MultiMap[K, V]) MarshalYAML() (interface{}, error) {
+	return m.lists.MarshalYAML()
+}
+
+func (m *MultiMap[K, V]) UnmarshalYAML(n *yaml.Node) error {
+	lists := NewMap[K, []V]()
+
+	if err := n.Decode(lists); err != nil {
+		return fmt.Errorf("decode lists: %w", err)
+	}
+
+	m.addLists(lists)
+
+	return nil
+}
+
+func (m *MultiMap[K, V]) addLists(lists *Map[K, []V]) {
+	m.lists.lazyInit()
+
+	for k, list := range lists.All() {
+		m.Add(k, list...)
+	}
+}
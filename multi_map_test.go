@@ -0,0 +1,150 @@
+package gds
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestMultiMap_Add(t *testing.T) {
+	m := NewMultiMap[string, string]()
+
+	m.Add("user_id", "idx_orders_user")
+	m.Add("email", "uniq_users_email")
+	m.Add("user_id", "idx_sessions_user", "idx_payments_user")
+
+	assert.Equal(t, []string{"user_id", "email"}, m.Keys())
+	assert.Equal(t, []string{"idx_orders_user", "idx_sessions_user", "idx_payments_user"}, m.GetAll("user_id"))
+	assert.Equal(t, 2, m.KeyCount())
+	assert.Equal(t, 4, m.ValueCount())
+	assert.True(t, m.HasValue("email", "uniq_users_email"))
+	assert.False(t, m.HasValue("email", "idx_orders_user"))
+	assert.Nil(t, m.GetAll("unknown"))
+
+	m.Add("name")
+	assert.False(t, m.Has("name"))
+}
+
+func TestMultiMap_GetAllReturnsCopy(t *testing.T) {
+	m := NewMultiMap[string, string]()
+	m.Add("email", "uniq_users_email")
+
+	list := m.GetAll("email")
+	list[0] = "changed"
+
+	assert.Equal(t, []string{"uniq_users_email"}, m.GetAll("email"))
+}
+
+func TestMultiMap_Remove(t *testing.T) {
+	m := NewMultiMap[string, string]()
+
+	m.Add("user_id", "idx_orders_user")
+	m.Add("email", "uniq_users_email")
+	m.Add("user_id", "idx_sessions_user", "idx_payments_user")
+
+	assert.True(t, m.Remove("user_id", "idx_sessions_user"))
+	assert.False(t, m.Remove("user_id", "idx_sessions_user"))
+	assert.False(t, m.Remove("unknown", "idx_sessions_user"))
+
+	assert.Equal(t, []string{"idx_orders_user", "idx_payments_user"}, m.GetAll("user_id"))
+	assert.Equal(t, 3, m.ValueCount())
+
+	assert.True(t, m.Remove("email", "uniq_users_email"))
+	assert.False(t, m.Has("email"))
+	assert.Equal(t, 1, m.KeyCount())
+	assert.Equal(t, 2, m.ValueCount())
+}
+
+func TestMultiMap_RemoveAll(t *testing.T) {
+	m := NewMultiMap[string, string]()
+
+	m.Add("user_id", "idx_orders_user")
+	m.Add("email", "uniq_users_email")
+	m.Add("user_id", "idx_sessions_user", "idx_payments_user")
+
+	assert.Equal(t, []string{"idx_orders_user", "idx_sessions_user", "idx_payments_user"}, m.RemoveAll("user_id"))
+	assert.Nil(t, m.RemoveAll("user_id"))
+
+	assert.Equal(t, []string{"email"}, m.Keys())
+	assert.Equal(t, 1, m.ValueCount())
+}
+
+func TestMultiMap_All(t *testing.T) {
+	m := NewMultiMap[string, string]()
+
+	m.Add("user_id", "idx_orders_user")
+	m.Add("email", "uniq_users_email")
+	m.Add("user_id", "idx_sessions_user", "idx_payments_user")
+
+	pairs := []string{}
+	for k, v := range m.All() {
+		pairs = append(pairs, k+"="+v)
+	}
+
+	assert.Equal(t, []string{
+		"user_id=idx_orders_user",
+		"user_id=idx_sessions_user",
+		"user_id=idx_payments_user",
+		"email=uniq_users_email",
+	}, pairs)
+
+	keys := []string{}
+	for k, list := range m.Lists() {
+		keys = append(keys, k)
+		list[0] = "changed"
+	}
+
+	assert.Equal(t, []string{"user_id", "email"}, keys)
+	assert.Equal(t, []string{"uniq_users_email"}, m.GetAll("email"))
+}
+
+func TestMultiMap_JSON(t *testing.T) {
+	m := NewMultiMap[string, string]()
+
+	m.Add("user_id", "idx_orders_user")
+	m.Add("email", "uniq_users_email")
+	m.Add("user_id", "idx_sessions_user", "idx_payments_user")
+
+	data, err := json.Marshal(m)
+	require.NoError(t, err)
+
+	assert.Equal(
+		t,
+		`{"user_id":["idx_orders_user","idx_sessions_user","idx_payments_user"],"email":["uniq_users_email"]}`,
+		string(data),
+	)
+
+	decoded := NewMultiMap[string, string]()
+	require.NoError(t, json.Unmarshal(data, decoded))
+
+	assert.Equal(t, m.Keys(), decoded.Keys())
+	assert.Equal(t, m.GetAll("user_id"), decoded.GetAll("user_id"))
+	assert.Equal(t, 4, decoded.ValueCount())
+}
+
+func TestMultiMap_YAML(t *testing.T) {
+	var spec struct {
+		Indexes *MultiMap[string, string] `yaml:"indexes"`
+	}
+
+	src := `indexes:
+    user_id:
+        - idx_orders_user
+        - idx_sessions_user
+    email:
+        - uniq_users_email
+`
+
+	require.NoError(t, yaml.Unmarshal([]byte(src), &spec))
+
+	assert.Equal(t, []string{"user_id", "email"}, spec.Indexes.Keys())
+	assert.Equal(t, 3, spec.Indexes.ValueCount())
+
+	got, err := yaml.Marshal(spec)
+	require.NoError(t, err)
+
+	assert.Equal(t, src, string(got))
+}
@@ -0,0 +1,245 @@
+package gds
+
+import (
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// formatPgArray builds a one-dimensional Postgres array literal, every element is quoted.
+func formatPgArray(elems []string) string {
+	var b strings.Builder
+
+	b.WriteByte('{')
+
+	for i, elem := range elems {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+
+		b.WriteByte('"')
+
+		for _, r := range elem {
+			if r == '"' || r == '\\' {
+				b.WriteByte('\\')
+			}
+			b.WriteRune(r)
+		}
+
+		b.WriteByte('"')
+	}
+
+	b.WriteByte('}')
+
+	return b.String()
+}
+
+// pgArrayElement is an element of a Postgres array literal, null is set for unquoted NULL.
+type pgArrayElement struct {
+	value string
+	null  bool
+}
+
+// parsePgArray parses a one-dimensional Postgres array literal like {a,"b c",NULL}.
+func parsePgArray(src string) ([]pgArrayElement, error) {
+	src = strings.TrimSpace(src)
+	if len(src) < 2 || src[0] != '{' || src[len(src)-1] != '}' {
+		return nil, errors.New("array literal must be enclosed in braces")
+	}
+
+	body := src[1 : len(src)-1]
+	elems := []pgArrayElement{}
+
+	if strings.TrimSpace(body) == "" {
+		return elems, nil
+	}
+
+	for i := 0; ; {
+		elem, next, err := parsePgArrayElement(body, i)
+		if err != nil {
+			return nil, err
+		}
+
+		elems = append(elems, elem)
+
+		if next >= len(body) {
+			return elems, nil
+		}
+
+		if body[next] != ',' {
+			return nil, fmt.Errorf("unexpected %q at position %d", body[next], next+1)
+		}
+
+		i = next + 1
+	}
+}
+
+func parsePgArrayElement(body string, start int) (elem pgArrayElement, next int, err error) {
+	i := start
+	for i < len(body) && body[i] == ' ' {
+		i++
+	}
+
+	if i < len(body) && body[i] == '"' {
+		var b strings.Builder
+
+		for i++; i < len(body); i++ {
+			switch body[i] {
+			case '\\':
+				i++
+				if i < len(body) {
+					b.WriteByte(body[i])
+				}
+			case '"':
+				i++
+				for i < len(body) && body[i] == ' ' {
+					i++
+				}
+
+				return pgArrayElement{value: b.String()}, i, nil
+			default:
+				b.WriteByte(body[i])
+			}
+		}
+
+		return elem, 0, errors.New("unterminated quoted element")
+	}
+
+	end := i
+	for end < len(body) && body[end] != ',' {
+		if body[end] == '{' || body[end] == '}' || body[end] == '"' {
+			return elem, 0, fmt.Errorf("unexpected %q at position %d, only one-dimensional arrays are supported", body[end], end+1)
+		}
+		end++
+	}
+
+	value := strings.TrimSpace(body[i:end])
+	if value == "" {
+		return elem, 0, fmt.Errorf("empty element at position %d", i+1)
+	}
+
+	if strings.EqualFold(value, "NULL") {
+		return pgArrayElement{null: true}, end, nil
+	}
+
+	return pgArrayElement{value: value}, end, nil
+}
+
+// formatSQLElement converts an item to text: strings are used directly,
+// then encoding.TextMarshaler, other values are encoded to JSON.
+func formatSQLElement(item any) (string, error) {
+	rv := reflect.ValueOf(item)
+	if rv.IsValid() && rv.Kind() == reflect.String {
+		return rv.String(), nil
+	}
+
+	if tm, ok := item.(encoding.TextMarshaler); ok {
+		text, err := tm.MarshalText()
+		if err != nil {
+			return "", err
+		}
+
+		return string(text), nil
+	}
+
+	data, err := json.Marshal(item)
+	if err != nil {
+		return "", err
+	}
+
+	return string(data), nil
+}
+
+// parseSQLElement is the reverse of formatSQLElement, item must be a pointer.
+func parseSQLElement(raw string, item any) error {
+	if tu, ok := item.(encoding.TextUnmarshaler); ok {
+		return tu.UnmarshalText([]byte(raw))
+	}
+
+	rv := reflect.ValueOf(item).Elem()
+	if rv.Kind() == reflect.String {
+		rv.SetString(raw)
+
+		return nil
+	}
+
+	return json.Unmarshal([]byte(raw), item)
+}
+
+// scanSQLList reads items from a Postgres array literal or a JSON array. NULL elements give zero values.
+func scanSQLList[T any](src any) ([]T, error) {
+	var raw string
+
+	switch v := src.(type) {
+	case string:
+		raw = v
+	case []byte:
+		raw = string(v)
+	default:
+		return nil, fmt.Errorf("unexpected type %q", reflect.TypeOf(src).String())
+	}
+
+	raw = strings.TrimSpace(raw)
+
+	if strings.HasPrefix(raw, "[") {
+		items := []T{}
+		if err := json.Unmarshal([]byte(raw), &items); err != nil {
+			return nil, fmt.Errorf("unmarshal json array: %w", err)
+		}
+
+		return items, nil
+	}
+
+	elems, err := parsePgArray(raw)
+	if err != nil {
+		return nil, fmt.Errorf("parse array: %w", err)
+	}
+
+	items := make([]T, len(elems))
+	for i, elem := range elems {
+		if elem.null {
+			continue
+		}
+
+		if err = parseSQLElement(elem.value, &items[i]); err != nil {
+			return nil, fmt.Errorf("parse element %q: %w", elem.value, err)
+		}
+	}
+
+	return items, nil
+}
+
+func valueSQLList[T any](items []T) (string, error) {
+	elems := make([]string, len(items))
+
+	for i, item := range items {
+		elem, err := formatSQLElement(item)
+		if err != nil {
+			return "", fmt.Errorf("format element: %w", err)
+		}
+
+		elems[i] = elem
+	}
+
+	return formatPgArray(elems), nil
+}
+
+// sqlJSON writes the value as JSON, e.g. to a json or jsonb column.
+type sqlJSON struct {
+	value json.Marshaler
+}
+
+func (j sqlJSON) Value() (driver.Value, error) {
+	return j.value.MarshalJSON()
+}
+
+// sqlNull writes NULL.
+type sqlNull struct{}
+
+func (sqlNull) Value() (driver.Value, error) {
+	return nil, nil //nolint:nilnil // NULL value
+}
@@ -0,0 +1,207 @@
+package gds
+
+import (
+	"maps"
+	"slices"
+)
+
+// PriorityQueue is a binary heap of unique items with priorities.
+// The item with the lowest priority by cmp is on top, so cmp.Compare gives a min-queue.
+// Items are tracked by index, so Update and Remove are O(log n).
+type PriorityQueue[T comparable, P any] struct {
+	items []pqItem[T, P]
+	index map[T]int
+	cmp   func(a, b P) int
+
+	nilVal T
+}
+
+type pqItem[T comparable, P any] struct {
+	value    T
+	priority P
+}
+
+var _ Collection[int] = (*PriorityQueue[int, int])(nil)
+
+func NewPriorityQueue[T comparable, P any](cmp func(a, b P) int) *PriorityQueue[T, P] {
+	return &PriorityQueue[T, P]{
+		index: map[T]int{},
+		cmp:   cmp,
+	}
+}
+
+// Push adds the item. Priority of an existing item is updated.
+func (q *PriorityQueue[T, P]) Push(item T, priority P) {
+	if q.Update(item, priority) {
+		return
+	}
+
+	q.items = append(q.items, pqItem[T, P]{value: item, priority: priority})
+	q.index[item] = len(q.items) - 1
+	q.up(len(q.items) - 1)
+}
+
+// Pop removes and returns the top item.
+func (q *PriorityQueue[T, P]) Pop() (T, bool) {
+	if len(q.items) == 0 {
+		return q.nilVal, false
+	}
+
+	top := q.items[0].value
+	q.removeAt(0)
+
+	return top, true
+}
+
+// Peek returns the top item.
+func (q *PriorityQueue[T, P]) Peek() (T, bool) {
+	if len(q.items) == 0 {
+		return q.nilVal, false
+	}
+
+	return q.items[0].value, true
+}
+
+func (q *PriorityQueue[T, P]) Priority(item T) (P, bool) {
+	i, has := q.index[item]
+	if !has {
+		var nilPriority P
+
+		return nilPriority, false
+	}
+
+	return q.items[i].priority, true
+}
+
+// Update changes priority of the item. It returns false when the item is absent.
+func (q *PriorityQueue[T, P]) Update(item T, priority P) bool {
+	i, has := q.index[item]
+	if !has {
+		return false
+	}
+
+	q.items[i].priority = priority
+	q.fix(i)
+
+	return true
+}
+
+// Remove deletes the item. It returns false when the item is absent.
+func (q *PriorityQueue[T, P]) Remove(item T) bool {
+	i, has := q.index[item]
+	if !has {
+		return false
+	}
+
+	q.removeAt(i)
+
+	return true
+}
+
+func (q *PriorityQueue[T, P]) Has(item T) bool {
+	_, has := q.index[item]
+
+	return has
+}
+
+// First returns the top item.
+func (q *PriorityQueue[T, P]) First() T {
+	top, _ := q.Peek()
+
+	return top
+}
+
+func (q *PriorityQueue[T, P]) Len() int {
+	return len(q.items)
+}
+
+func (q *PriorityQueue[T, P]) IsEmpty() bool {
+	return len(q.items) == 0
+}
+
+func (q *PriorityQueue[T, P]) IsNotEmpty() bool {
+	return len(q.items) > 0
+}
+
+// List returns items in the order they would be popped. It pops a copy of the queue, so it is O(n log n).
+func (q *PriorityQueue[T, P]) List() []T {
+	popped := &PriorityQueue[T, P]{
+		items: slices.Clone(q.items),
+		index: maps.Clone(q.index),
+		cmp:   q.cmp,
+	}
+
+	list := make([]T, 0, len(q.items))
+	for item, ok := popped.Pop(); ok; item, ok = popped.Pop() {
+		list = append(list, item)
+	}
+
+	return list
+}
+
+func (q *PriorityQueue[T, P]) removeAt(i int) {
+	last := len(q.items) - 1
+
+	delete(q.index, q.items[i].value)
+
+	if i != last {
+		q.items[i] = q.items[last]
+		q.index[q.items[i].value] = i
+	}
+
+	q.items[last] = pqItem[T, P]{}
+	q.items = q.items[:last]
+
+	if i < len(q.items) {
+		q.fix(i)
+	}
+}
+
+func (q *PriorityQueue[T, P]) fix(i int) {
+	if !q.down(i) {
+		q.up(i)
+	}
+}
+
+func (q *PriorityQueue[T, P]) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2 //nolint:mnd // binary heap
+		if q.cmp(q.items[i].priority, q.items[parent].priority) >= 0 {
+			return
+		}
+
+		q.swap(i, parent)
+		i = parent
+	}
+}
+
+func (q *PriorityQueue[T, P]) down(i int) bool {
+	start := i
+
+	for {
+		smallest := i
+		left := 2*i + 1 //nolint:mnd // binary heap
+		right := left + 1
+
+		if left < len(q.items) && q.cmp(q.items[left].priority, q.items[smallest].priority) < 0 {
+			smallest = left
+		}
+
+		if right < len(q.items) && q.cmp(q.items[right].priority, q.items[smallest].priority) < 0 {
+			smallest = right
+		}
+
+		if smallest == i {
+			return i > start
+		}
+
+		q.swap(i, smallest)
+		i = smallest
+	}
+}
+
+func (q *PriorityQueue[T, P]) swap(i, j int) {
+	q.items[i], q.items[j] = q.items[j], q.items[i]
+	q.index[q.items[i].value] = i
+	q.index[q.items[j].value] = j
+}
@@ -0,0 +1,190 @@
+package gds
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func popAll[T comparable, P any](q *PriorityQueue[T, P]) []T {
+	items := []T{}
+
+	for q.IsNotEmpty() {
+		item, _ := q.Pop()
+		items = append(items, item)
+	}
+
+	return items
+}
+
+func TestPriorityQueue_PushPop(t *testing.T) {
+	q := NewPriorityQueue[string, int](cmp.Compare[int])
+
+	q.Push("deploy", 3)
+	q.Push("build", 1)
+	q.Push("notify", 5)
+	q.Push("test", 2)
+
+	assert.Equal(t, 4, q.Len())
+	assert.Equal(t, "build", q.First())
+	assert.Equal(t, []string{"build", "test", "deploy", "notify"}, q.List())
+
+	top, ok := q.Peek()
+	require.True(t, ok)
+	assert.Equal(t, "build", top)
+
+	assert.Equal(t, []string{"build", "test", "deploy", "notify"}, popAll(q))
+
+	_, ok = q.Pop()
+	assert.False(t, ok)
+
+	_, ok = q.Peek()
+	assert.False(t, ok)
+	assert.True(t, q.IsEmpty())
+}
+
+func TestPriorityQueue_ListWithEqualPriorities(t *testing.T) {
+	q := NewPriorityQueue[string, int](cmp.Compare[int])
+
+	q.Push("a", 1)
+	q.Push("b", 1)
+	q.Push("c", 1)
+	q.Push("d", 1)
+
+	list := q.List()
+
+	assert.Equal(t, 4, q.Len())
+	assert.Equal(t, popAll(q), list)
+}
+
+func TestPriorityQueue_MaxQueue(t *testing.T) {
+	q := NewPriorityQueue[string, int](func(a, b int) int {
+		return cmp.Compare(b, a)
+	})
+
+	q.Push("low", 1)
+	q.Push("high", 10)
+	q.Push("mid", 5)
+
+	assert.Equal(t, []string{"high", "mid", "low"}, popAll(q))
+}
+
+func TestPriorityQueue_Update(t *testing.T) {
+	q := NewPriorityQueue[string, int](cmp.Compare[int])
+
+	q.Push("a", 1)
+	q.Push("b", 2)
+	q.Push("c", 3)
+	q.Push("d", 4)
+
+	assert.True(t, q.Update("d", 0))
+	assert.True(t, q.Update("a", 10))
+	assert.False(t, q.Update("x", 1))
+
+	q.Push("b", 5)
+
+	priority, ok := q.Priority("b")
+	require.True(t, ok)
+	assert.Equal(t, 5, priority)
+
+	_, ok = q.Priority("x")
+	assert.False(t, ok)
+
+	assert.Equal(t, 4, q.Len())
+	assert.Equal(t, []string{"d", "c", "b", "a"}, popAll(q))
+}
+
+func TestPriorityQueue_Remove(t *testing.T) {
+	q := NewPriorityQueue[int, int](cmp.Compare[int])
+
+	for i := 0; i < 10; i++ {
+		q.Push(i, (i*7)%10)
+	}
+
+	assert.True(t, q.Remove(0))
+	assert.True(t, q.Remove(5))
+	assert.False(t, q.Remove(5))
+	assert.False(t, q.Has(5))
+	assert.True(t, q.Has(6))
+
+	assert.Equal(t, []int{3, 6, 9, 2, 8, 1, 4, 7}, popAll(q))
+}
+
+func TestPriorityQueue_MatchesSort(t *testing.T) {
+	q := NewPriorityQueue[int, int](cmp.Compare[int])
+	priorities := map[int]int{}
+
+	for i := 0; i < 500; i++ {
+		priority := (i * 7919) % 503
+		q.Push(i, priority)
+		priorities[i] = priority
+
+		if i%7 == 0 {
+			q.Update(i/2, -priority)
+			priorities[i/2] = -priority
+		}
+
+		if i%11 == 0 {
+			q.Remove(i / 3)
+			delete(priorities, i/3)
+		}
+	}
+
+	expected := make([]int, 0, len(priorities))
+	for item := range priorities {
+		expected = append(expected, item)
+	}
+
+	slices.SortFunc(expected, func(a, b int) int {
+		return cmp.Or(cmp.Compare(priorities[a], priorities[b]), cmp.Compare(a, b))
+	})
+
+	got := popAll(q)
+	require.Len(t, got, len(expected))
+
+	for i := range got {
+		assert.Equal(t, priorities[expected[i]], priorities[got[i]])
+	}
+}
+
+func TestPriorityQueue_MergeSortedStrings(t *testing.T) {
+	lists := []*Strings{
+		NewStrings("apple", "melon", "peach"),
+		NewStrings("banana", "cherry"),
+		NewStrings(),
+		NewStrings("apple", "kiwi", "zucchini"),
+	}
+
+	positions := make([]int, len(lists))
+	q := NewPriorityQueue[int, string](strings.Compare)
+
+	for i, list := range lists {
+		if list.IsNotEmpty() {
+			q.Push(i, list.First())
+		}
+	}
+
+	merged := NewStrings()
+
+	for q.IsNotEmpty() {
+		i, _ := q.Peek()
+		val, _ := q.Priority(i)
+
+		merged.Add(val)
+		positions[i]++
+
+		if positions[i] < lists[i].Len() {
+			q.Update(i, lists[i].List()[positions[i]])
+		} else {
+			q.Remove(i)
+		}
+	}
+
+	assert.Equal(t, []string{
+		"apple", "apple", "banana", "cherry", "kiwi", "melon", "peach", "zucchini",
+	}, merged.List())
+}
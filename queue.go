@@ -0,0 +1,60 @@
+package gds
+
+import "iter"
+
+// Queue is a FIFO collection on Deque. The zero value is an empty queue ready to use.
+type Queue[T any] struct {
+	items Deque[T]
+}
+
+var _ Collection[int] = (*Queue[int])(nil)
+
+func NewQueue[T any](values ...T) *Queue[T] {
+	return &Queue[T]{
+		items: *NewDeque(values...),
+	}
+}
+
+func (q *Queue[T]) Push(values ...T) {
+	for _, value := range values {
+		q.items.PushBack(value)
+	}
+}
+
+func (q *Queue[T]) Pop() (T, bool) {
+	return q.items.PopFront()
+}
+
+func (q *Queue[T]) Peek() (T, bool) {
+	return q.items.PeekFront()
+}
+
+// First returns the front item.
+func (q *Queue[T]) First() T {
+	return q.items.First()
+}
+
+func (q *Queue[T]) Len() int {
+	return q.items.Len()
+}
+
+func (q *Queue[T]) IsEmpty() bool {
+	return q.items.IsEmpty()
+}
+
+func (q *Queue[T]) IsNotEmpty() bool {
+	return q.items.IsNotEmpty()
+}
+
+// List returns items from the front to the back.
+func (q *Queue[T]) List() []T {
+	return q.items.List()
+}
+
+func (q *Queue[T]) All() iter.Seq[T] {
+	return q.items.All()
+}
+
+func (q *Queue[T]) Clear() {
+	q.items.Clear()
+}
@@ -0,0 +1,57 @@
+package gds
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueue(t *testing.T) {
+	q := NewQueue[string]("a", "b")
+
+	q.Push("c")
+
+	assert.Equal(t, 3, q.Len())
+	assert.Equal(t, "a", q.First())
+	assert.Equal(t, []string{"a", "b", "c"}, q.List())
+
+	front, ok := q.Peek()
+	require.True(t, ok)
+	assert.Equal(t, "a", front)
+
+	for _, expected := range []string{"a", "b", "c"} {
+		val, popped := q.Pop()
+		require.True(t, popped)
+		assert.Equal(t, expected, val)
+	}
+
+	_, ok = q.Pop()
+	assert.False(t, ok)
+	assert.True(t, q.IsEmpty())
+}
+
+func TestQueue_BreadthFirstTraversal(t *testing.T) {
+	tree := map[string][]string{
+		"root": {"a", "b"},
+		"a":    {"a1", "a2"},
+		"b":    {"b1"},
+	}
+
+	var q Queue[string]
+
+	q.Push("root")
+	visited := []string{}
+
+	for q.IsNotEmpty() {
+		node, _ := q.Pop()
+		visited = append(visited, node)
+
+		q.Push(tree[node]...)
+	}
+
+	assert.Equal(t, []string{"root", "a", "b", "a1", "a2", "b1"}, visited)
+
+	q.Clear()
+	assert.False(t, q.IsNotEmpty())
+}
@@ -1,23 +1,24 @@
 package gds
 
 import (
+	"database/sql/driver"
+	"encoding/json"
 	"fmt"
 	"gopkg.in/yaml.v3"
-	"maps"
-	"slices"
+	"iter"
 )
 
+// Set keeps unique items in insertion order. The zero value is an empty set ready to use.
+// Items are stored as keys of Map, so Remove doesn't scan the items.
 type Set[T comparable] struct {
-	set  map[T]bool
-	list []T
+	items Map[T, struct{}]
 
 	nilVal T
 }
 
 func NewSet[T comparable](values ...T) *Set[T] {
 	set := &Set[T]{
-		set:  map[T]bool{},
-		list: make([]T, 0, len(values)),
+		items: *newMapWithCapacity[T, struct{}](len(values)),
 	}
 
 	for _, value := range values {
@@ -27,62 +28,201 @@ func NewSet[T comparable](values ...T) *Set[T] {
 	return set
 }
 
+func NewSetFromSeq[T comparable](seq iter.Seq[T]) *Set[T] {
+	set := NewSet[T]()
+
+	for value := range seq {
+		set.Add(value)
+	}
+
+	return set
+}
+
 func (s *Set[T]) First() T {
-	if s.IsEmpty() {
-		return s.nilVal
+	for item := range s.All() {
+		return item
 	}
-	return s.list[0]
+
+	return s.nilVal
 }
 
 func (s *Set[T]) Add(val T) {
-	_, exists := s.set[val]
-	if exists {
+	if s.items.Has(val) {
 		return
 	}
 
-	s.list = append(s.list, val)
-	s.set[val] = true
+	s.items.Set(val, struct{}{})
+}
+
+// Remove deletes the item and reports whether it was present.
+func (s *Set[T]) Remove(val T) bool {
+	if !s.items.Has(val) {
+		return false
+	}
+
+	s.items.Delete(val)
+
+	return true
+}
+
+// Pop removes the last added item and returns it.
+func (s *Set[T]) Pop() (T, bool) {
+	for item := range s.Backward() {
+		s.items.Delete(item)
+
+		return item, true
+	}
+
+	return s.nilVal, false
+}
+
+func (s *Set[T]) Clear() {
+	s.items.reset()
 }
 
 func (s *Set[T]) List() []T {
-	return s.list
+	return s.items.Keys()
+}
+
+func (s *Set[T]) All() iter.Seq[T] {
+	return s.items.KeysSeq()
+}
+
+func (s *Set[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for item := range s.items.Backward() {
+			if !yield(item) {
+				return
+			}
+		}
+	}
 }
 
 func (s *Set[T]) IsEmpty() bool {
-	return len(s.list) == 0
+	return s.items.IsEmpty()
 }
 
 func (s *Set[T]) IsNotEmpty() bool {
-	return len(s.list) > 0
+	return s.items.IsNotEmpty()
 }
 
 func (s *Set[T]) Has(value T) bool {
-	return s.set[value]
+	return s.items.Has(value)
 }
 
 func (s *Set[T]) Len() int {
-	return len(s.list)
+	return s.items.Len()
 }
 
 func (s *Set[T]) Merge(that *Set[T]) *Set[T] {
 	newSet := s.Clone()
 
-	for _, item := range that.list {
+	for item := range that.All() {
 		newSet.Add(item)
 	}
 
 	return newSet
 }
 
+// Union returns items of both sets: items of s first, then new items of that.
+func (s *Set[T]) Union(that *Set[T]) *Set[T] {
+	return s.Merge(that)
+}
+
+// Intersect returns items of s which are present in that.
+func (s *Set[T]) Intersect(that *Set[T]) *Set[T] {
+	newSet := NewSet[T]()
+
+	for item := range s.All() {
+		if that.Has(item) {
+			newSet.Add(item)
+		}
+	}
+
+	return newSet
+}
+
+// Difference returns items of s which are not present in that.
+func (s *Set[T]) Difference(that *Set[T]) *Set[T] {
+	newSet := NewSet[T]()
+
+	for item := range s.All() {
+		if !that.Has(item) {
+			newSet.Add(item)
+		}
+	}
+
+	return newSet
+}
+
+// SymmetricDifference returns items present in only one of the sets: items of s first, then items of that.
+func (s *Set[T]) SymmetricDifference(that *Set[T]) *Set[T] {
+	newSet := s.Difference(that)
+
+	for item := range that.All() {
+		if !s.Has(item) {
+			newSet.Add(item)
+		}
+	}
+
+	return newSet
+}
+
+func (s *Set[T]) IsSubset(that *Set[T]) bool {
+	if s.Len() > that.Len() {
+		return false
+	}
+
+	for item := range s.All() {
+		if !that.Has(item) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (s *Set[T]) IsSuperset(that *Set[T]) bool {
+	return that.IsSubset(s)
+}
+
+func (s *Set[T]) IsDisjoint(that *Set[T]) bool {
+	small, big := s, that
+	if small.Len() > big.Len() {
+		small, big = big, small
+	}
+
+	for item := range small.All() {
+		if big.Has(item) {
+			return false
+		}
+	}
+
+	return true
+}
+
 func (s *Set[T]) Clone() *Set[T] {
 	return &Set[T]{
-		set:  maps.Clone(s.set),
-		list: slices.Clone(s.list),
+		items: *s.items.Clone(),
+	}
+}
+
+// CloneFunc returns a set of items passed through cloner.
+// Items which become equal after cloning are kept once.
+func (s *Set[T]) CloneFunc(cloner func(T) T) *Set[T] {
+	newSet := &Set[T]{
+		items: *newMapWithCapacity[T, struct{}](s.Len()),
+	}
+
+	for item := range s.All() {
+		newSet.Add(cloner(item))
 	}
+
+	return newSet
 }
 
 func (s *Set[T]) Walk(callback func(item T) bool) {
-	for _, item := range s.list {
+	for item := range s.All() {
 		continueWalk := callback(item)
 		if !continueWalk {
 			return
@@ -95,7 +235,7 @@ func (s *Set[T]) Equal(that *Set[T]) bool {
 		return false
 	}
 
-	for item := range s.set {
+	for item := range s.All() {
 		if !that.Has(item) {
 			return false
 		}
@@ -109,9 +249,7 @@ func (s *Set[T]) UnmarshalYAML(n *yaml.Node) error {
 		return fmt.Errorf("yaml must contain a sequence node, has %v", n.Kind)
 	}
 
-	if s.set == nil {
-		s.set = map[T]bool{}
-	}
+	s.items.lazyInit()
 
 	for _, item := range n.Content {
 		var val T
@@ -125,3 +263,67 @@ func (s *Set[T]) UnmarshalYAML(n *yaml.Node) error {
 
 	return nil
 }
+
+func (s *Set[T]) MarshalYAML() (interface{}, error) {
+	return s.List(), nil
+}
+
+func (s *Set[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.List())
+}
+
+func (s *Set[T]) UnmarshalJSON(data []byte) error {
+	var items []T
+
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+
+	s.items.lazyInit()
+
+	for _, item := range items {
+		s.Add(item)
+	}
+
+	return nil
+}
+
+// Scan reads the set from a Postgres array literal, e.g. a text[] column, or from a JSON array.
+// NULL gives an empty set, NULL elements of the array give zero values.
+func (s *Set[T]) Scan(src any) error {
+	s.Clear()
+
+	if src == nil {
+		return nil
+	}
+
+	items, err := scanSQLList[T](src)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range items {
+		s.Add(item)
+	}
+
+	return nil
+}
+
+// Value writes the set as a Postgres array literal, nil set is written as NULL.
+func (s *Set[T]) Value() (driver.Value, error) {
+	if s == nil {
+		return nil, nil //nolint:nilnil // NULL value
+	}
+
+	return valueSQLList(s.List())
+}
+
+// JSON returns a driver.Valuer which writes the set as a JSON array, e.g. to a jsonb column.
+// Nil set is written as NULL.
+func (s *Set[T]) JSON() driver.Valuer {
+	if s == nil {
+		return sqlNull{}
+	}
+
+	return sqlJSON{value: s}
+}
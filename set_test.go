@@ -1,8 +1,11 @@
 package gds
 
 import (
+	"encoding/json"
 	"github.com/stretchr/testify/require"
 	"gopkg.in/yaml.v3"
+	"slices"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -87,3 +90,388 @@ ids: [1,2,3,4,5]
 		assert.Equal(t, NewSet[int](1, 2, 3, 4, 5), spec.IDs)
 	})
 }
+
+func TestMarshalYAML(t *testing.T) {
+	spec := struct {
+		IDs *Set[int] `yaml:"ids"`
+	}{
+		IDs: NewSet[int](3, 1, 2),
+	}
+
+	got, err := yaml.Marshal(spec)
+	require.NoError(t, err)
+
+	assert.Equal(t, "ids:\n    - 3\n    - 1\n    - 2\n", string(got))
+
+	var decoded struct {
+		IDs *Set[int] `yaml:"ids"`
+	}
+
+	require.NoError(t, yaml.Unmarshal(got, &decoded))
+	assert.Equal(t, spec.IDs.List(), decoded.IDs.List())
+}
+
+func TestIterators(t *testing.T) {
+	set := NewSet[int](3, 1, 2)
+
+	assert.Equal(t, []int{3, 1, 2}, slices.Collect(set.All()))
+	assert.Equal(t, []int{2, 1, 3}, slices.Collect(set.Backward()))
+
+	items := []int{}
+	for item := range set.All() {
+		if item == 2 {
+			break
+		}
+		items = append(items, item)
+	}
+
+	assert.Equal(t, []int{3, 1}, items)
+}
+
+func TestNewSetFromSeq(t *testing.T) {
+	set := NewSetFromSeq(slices.Values([]string{"b", "a", "b", "c"}))
+
+	assert.Equal(t, []string{"b", "a", "c"}, set.List())
+}
+
+func TestRemove(t *testing.T) {
+	set := NewSet[string]("a", "b", "c", "d")
+
+	assert.True(t, set.Remove("b"))
+	assert.False(t, set.Remove("b"))
+	assert.False(t, set.Remove("x"))
+
+	assert.Equal(t, []string{"a", "c", "d"}, set.List())
+	assert.False(t, set.Has("b"))
+	assert.Equal(t, 3, set.Len())
+
+	set.Add("b")
+
+	assert.Equal(t, []string{"a", "c", "d", "b"}, set.List())
+}
+
+func TestPop(t *testing.T) {
+	set := NewSet[int](1, 2, 3)
+
+	item, ok := set.Pop()
+	require.True(t, ok)
+	assert.Equal(t, 3, item)
+
+	item, ok = set.Pop()
+	require.True(t, ok)
+	assert.Equal(t, 2, item)
+
+	item, ok = set.Pop()
+	require.True(t, ok)
+	assert.Equal(t, 1, item)
+
+	_, ok = set.Pop()
+	require.False(t, ok)
+	assert.True(t, set.IsEmpty())
+}
+
+func TestClear(t *testing.T) {
+	set := NewSet[int](1, 2, 3)
+
+	set.Clear()
+
+	assert.True(t, set.IsEmpty())
+	assert.False(t, set.Has(1))
+
+	set.Add(4)
+
+	assert.Equal(t, []int{4}, set.List())
+}
+
+func TestSetAlgebra(t *testing.T) {
+	a := NewSet[int](5, 1, 3, 2)
+	b := NewSet[int](4, 3, 6, 1)
+
+	cases := []struct {
+		Title    string
+		Got      *Set[int]
+		Expected []int
+	}{
+		{
+			Title:    "union",
+			Got:      a.Union(b),
+			Expected: []int{5, 1, 3, 2, 4, 6},
+		},
+		{
+			Title:    "intersect",
+			Got:      a.Intersect(b),
+			Expected: []int{1, 3},
+		},
+		{
+			Title:    "difference",
+			Got:      a.Difference(b),
+			Expected: []int{5, 2},
+		},
+		{
+			Title:    "symmetric difference",
+			Got:      a.SymmetricDifference(b),
+			Expected: []int{5, 2, 4, 6},
+		},
+		{
+			Title:    "intersect with empty set",
+			Got:      a.Intersect(NewSet[int]()),
+			Expected: []int{},
+		},
+	}
+
+	for _, tCase := range cases {
+		t.Run(tCase.Title, func(t *testing.T) {
+			assert.Equal(t, tCase.Expected, tCase.Got.List())
+		})
+	}
+
+	t.Run("operands are not changed", func(t *testing.T) {
+		assert.Equal(t, []int{5, 1, 3, 2}, a.List())
+		assert.Equal(t, []int{4, 3, 6, 1}, b.List())
+	})
+}
+
+func TestSetRelations(t *testing.T) {
+	cases := []struct {
+		Title string
+
+		One *Set[int]
+		Two *Set[int]
+
+		Subset   bool
+		Superset bool
+		Disjoint bool
+	}{
+		{
+			Title:    "empty sets",
+			One:      NewSet[int](),
+			Two:      NewSet[int](),
+			Subset:   true,
+			Superset: true,
+			Disjoint: true,
+		},
+		{
+			Title:    "subset",
+			One:      NewSet[int](2, 1),
+			Two:      NewSet[int](1, 2, 3),
+			Subset:   true,
+			Superset: false,
+			Disjoint: false,
+		},
+		{
+			Title:    "superset",
+			One:      NewSet[int](1, 2, 3),
+			Two:      NewSet[int](3),
+			Subset:   false,
+			Superset: true,
+			Disjoint: false,
+		},
+		{
+			Title:    "disjoint",
+			One:      NewSet[int](1, 2),
+			Two:      NewSet[int](3, 4),
+			Subset:   false,
+			Superset: false,
+			Disjoint: true,
+		},
+		{
+			Title:    "overlapping",
+			One:      NewSet[int](1, 2),
+			Two:      NewSet[int](2, 3),
+			Subset:   false,
+			Superset: false,
+			Disjoint: false,
+		},
+	}
+
+	for _, tCase := range cases {
+		t.Run(tCase.Title, func(t *testing.T) {
+			assert.Equal(t, tCase.Subset, tCase.One.IsSubset(tCase.Two))
+			assert.Equal(t, tCase.Superset, tCase.One.IsSuperset(tCase.Two))
+			assert.Equal(t, tCase.Disjoint, tCase.One.IsDisjoint(tCase.Two))
+		})
+	}
+}
+
+func TestClone(t *testing.T) {
+	t.Run("clone is independent", func(t *testing.T) {
+		set := NewSet[int](1, 2, 3)
+
+		cloned := set.Clone()
+		cloned.Add(4)
+		cloned.Remove(1)
+
+		assert.Equal(t, []int{1, 2, 3}, set.List())
+		assert.Equal(t, []int{2, 3, 4}, cloned.List())
+	})
+
+	t.Run("clone with cloner", func(t *testing.T) {
+		set := NewSet[string]("a", "B", "b")
+
+		cloned := set.CloneFunc(strings.ToLower)
+
+		assert.Equal(t, []string{"a", "b"}, cloned.List())
+		assert.Equal(t, []string{"a", "B", "b"}, set.List())
+	})
+}
+
+func TestJSON(t *testing.T) {
+	data, err := json.Marshal(NewSet[int](3, 1, 2))
+	require.NoError(t, err)
+
+	assert.Equal(t, `[3,1,2]`, string(data))
+
+	got := NewSet[int]()
+	require.NoError(t, json.Unmarshal([]byte(`[2,2,1]`), got))
+
+	assert.Equal(t, []int{2, 1}, got.List())
+}
+
+func TestZeroValue(t *testing.T) {
+	cases := []struct {
+		Title string
+		Check func(t *testing.T, s *Set[int])
+	}{
+		{
+			Title: "read methods",
+			Check: func(t *testing.T, s *Set[int]) {
+				assert.False(t, s.Has(1))
+				assert.Equal(t, 0, s.First())
+				assert.Equal(t, 0, s.Len())
+				assert.True(t, s.IsEmpty())
+				assert.False(t, s.IsNotEmpty())
+				assert.Equal(t, []int{}, s.List())
+				assert.Empty(t, slices.Collect(s.All()))
+				assert.Empty(t, slices.Collect(s.Backward()))
+				assert.True(t, s.Equal(NewSet[int]()))
+
+				s.Walk(func(int) bool {
+					require.Fail(t, "walk over empty set")
+					return true
+				})
+			},
+		},
+		{
+			Title: "add",
+			Check: func(t *testing.T, s *Set[int]) {
+				s.Add(1)
+				s.Add(1)
+
+				assert.Equal(t, []int{1}, s.List())
+			},
+		},
+		{
+			Title: "remove",
+			Check: func(t *testing.T, s *Set[int]) {
+				assert.False(t, s.Remove(1))
+
+				_, ok := s.Pop()
+				assert.False(t, ok)
+			},
+		},
+		{
+			Title: "clear",
+			Check: func(t *testing.T, s *Set[int]) {
+				s.Clear()
+				s.Add(1)
+
+				assert.Equal(t, []int{1}, s.List())
+			},
+		},
+		{
+			Title: "algebra",
+			Check: func(t *testing.T, s *Set[int]) {
+				that := NewSet[int](1)
+
+				assert.Equal(t, []int{1}, s.Union(that).List())
+				assert.Equal(t, []int{1}, s.Merge(that).List())
+				assert.Equal(t, []int{}, s.Intersect(that).List())
+				assert.Equal(t, []int{}, s.Difference(that).List())
+				assert.Equal(t, []int{1}, s.SymmetricDifference(that).List())
+				assert.True(t, s.IsSubset(that))
+				assert.False(t, s.IsSuperset(that))
+				assert.True(t, s.IsDisjoint(that))
+			},
+		},
+		{
+			Title: "clone",
+			Check: func(t *testing.T, s *Set[int]) {
+				cloned := s.Clone()
+				cloned.Add(1)
+
+				clonedFunc := s.CloneFunc(func(v int) int {
+					return v
+				})
+				clonedFunc.Add(1)
+
+				assert.True(t, s.IsEmpty())
+			},
+		},
+		{
+			Title: "marshal",
+			Check: func(t *testing.T, s *Set[int]) {
+				data, err := json.Marshal(s)
+				require.NoError(t, err)
+				assert.Equal(t, `[]`, string(data))
+
+				data, err = yaml.Marshal(s)
+				require.NoError(t, err)
+				assert.Equal(t, "[]\n", string(data))
+
+				value, err := s.Value()
+				require.NoError(t, err)
+				assert.Equal(t, `{}`, value)
+			},
+		},
+		{
+			Title: "unmarshal",
+			Check: func(t *testing.T, s *Set[int]) {
+				require.NoError(t, json.Unmarshal([]byte(`[1]`), s))
+				require.NoError(t, yaml.Unmarshal([]byte(`[2]`), s))
+
+				assert.Equal(t, []int{1, 2}, s.List())
+			},
+		},
+		{
+			Title: "scan",
+			Check: func(t *testing.T, s *Set[int]) {
+				require.NoError(t, s.Scan(`{1,2}`))
+
+				assert.Equal(t, []int{1, 2}, s.List())
+			},
+		},
+	}
+
+	for _, tCase := range cases {
+		t.Run(tCase.Title, func(t *testing.T) {
+			var s Set[int]
+
+			tCase.Check(t, &s)
+		})
+	}
+}
+
+func TestRemoveDuringIteration(t *testing.T) {
+	set := NewSet(0, 1, 2, 3, 4, 5, 6, 7, 8, 9)
+
+	for item := range set.All() {
+		if item < 8 {
+			set.Remove(item)
+		}
+	}
+
+	assert.Equal(t, []int{8, 9}, set.List())
+
+	visited := []int{}
+
+	for item := range set.All() {
+		visited = append(visited, item)
+		set.Clear()
+	}
+
+	assert.Equal(t, []int{8}, visited)
+	assert.True(t, set.IsEmpty())
+
+	set.Add(1)
+	assert.Equal(t, []int{1}, set.List())
+}
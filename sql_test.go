@@ -0,0 +1,379 @@
+package gds
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeSQLDriver keeps one cell per DSN: Exec stores its single argument, Query returns it.
+type fakeSQLDriver struct {
+	mu    sync.Mutex
+	cells map[string]driver.Value
+}
+
+type fakeSQLConn struct {
+	driver *fakeSQLDriver
+	dsn    string
+}
+
+type fakeSQLStmt struct {
+	conn *fakeSQLConn
+}
+
+type fakeSQLRows struct {
+	value driver.Value
+	done  bool
+}
+
+var fakeDriver = &fakeSQLDriver{cells: map[string]driver.Value{}}
+
+func init() { //nolint:gochecknoinits // drivers can be registered only once
+	sql.Register("gds-fake", fakeDriver)
+}
+
+func (d *fakeSQLDriver) Open(dsn string) (driver.Conn, error) {
+	return &fakeSQLConn{driver: d, dsn: dsn}, nil
+}
+
+func (c *fakeSQLConn) Prepare(_ string) (driver.Stmt, error) {
+	return &fakeSQLStmt{conn: c}, nil
+}
+
+func (c *fakeSQLConn) Close() error {
+	return nil
+}
+
+func (c *fakeSQLConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("transactions are not supported")
+}
+
+func (s *fakeSQLStmt) Close() error {
+	return nil
+}
+
+func (s *fakeSQLStmt) NumInput() int {
+	return -1
+}
+
+func (s *fakeSQLStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.conn.driver.mu.Lock()
+	defer s.conn.driver.mu.Unlock()
+
+	s.conn.driver.cells[s.conn.dsn] = args[0]
+
+	return driver.RowsAffected(1), nil
+}
+
+func (s *fakeSQLStmt) Query(_ []driver.Value) (driver.Rows, error) {
+	s.conn.driver.mu.Lock()
+	defer s.conn.driver.mu.Unlock()
+
+	return &fakeSQLRows{value: s.conn.driver.cells[s.conn.dsn]}, nil
+}
+
+func (r *fakeSQLRows) Columns() []string {
+	return []string{"value"}
+}
+
+func (r *fakeSQLRows) Close() error {
+	return nil
+}
+
+func (r *fakeSQLRows) Next(dest []driver.Value) error {
+	if r.done {
+		return io.EOF
+	}
+
+	r.done = true
+	dest[0] = r.value
+
+	return nil
+}
+
+func openFakeDB(t *testing.T) *sql.DB {
+	t.Helper()
+
+	db, err := sql.Open("gds-fake", t.Name())
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = db.Close()
+	})
+
+	return db
+}
+
+func storeFakeCell(t *testing.T, db *sql.DB, value any) driver.Value {
+	t.Helper()
+
+	_, err := db.ExecContext(context.Background(), "insert", value)
+	require.NoError(t, err)
+
+	fakeDriver.mu.Lock()
+	defer fakeDriver.mu.Unlock()
+
+	return fakeDriver.cells[t.Name()]
+}
+
+func loadFakeCell(t *testing.T, db *sql.DB, dest any) {
+	t.Helper()
+
+	require.NoError(t, db.QueryRowContext(context.Background(), "select").Scan(dest))
+}
+
+func TestMap_SQL(t *testing.T) {
+	t.Run("round trip", func(t *testing.T) {
+		db := openFakeDB(t)
+
+		m := NewMap[string, []int]()
+		m.Set("b", []int{1, 2})
+		m.Set("a", nil)
+
+		stored := storeFakeCell(t, db, m)
+		assert.Equal(t, []byte(`{"b":[1,2],"a":null}`), stored)
+
+		got := NewMap[string, []int]()
+		got.Set("old", []int{0})
+		loadFakeCell(t, db, got)
+
+		assert.Equal(t, []string{"b", "a"}, got.Keys())
+		assert.Equal(t, [][]int{{1, 2}, nil}, got.List())
+	})
+
+	t.Run("null", func(t *testing.T) {
+		db := openFakeDB(t)
+
+		stored := storeFakeCell(t, db, (*Map[string, int])(nil))
+		assert.Nil(t, stored)
+
+		got := NewMap[string, int]()
+		got.Set("old", 1)
+		loadFakeCell(t, db, got)
+
+		assert.True(t, got.IsEmpty())
+	})
+
+	t.Run("scan string", func(t *testing.T) {
+		got := NewMap[string, int]()
+
+		require.NoError(t, got.Scan(`{"x":1}`))
+		assert.Equal(t, []string{"x"}, got.Keys())
+
+		require.Error(t, got.Scan(1))
+		require.Error(t, got.Scan(`[1]`))
+	})
+}
+
+func TestSet_SQL(t *testing.T) {
+	t.Run("text array round trip", func(t *testing.T) {
+		db := openFakeDB(t)
+
+		set := NewSet[string]("a", `quoted "b"`, `back\slash`, "with,comma", "NULL", "")
+
+		stored := storeFakeCell(t, db, set)
+		assert.Equal(t, `{"a","quoted \"b\"","back\\slash","with,comma","NULL",""}`, stored)
+
+		got := NewSet[string]("old")
+		loadFakeCell(t, db, got)
+
+		assert.Equal(t, set.List(), got.List())
+	})
+
+	t.Run("int array", func(t *testing.T) {
+		db := openFakeDB(t)
+
+		stored := storeFakeCell(t, db, NewSet[int](3, 1))
+		assert.Equal(t, `{"3","1"}`, stored)
+
+		got := NewSet[int]()
+		loadFakeCell(t, db, got)
+
+		assert.Equal(t, []int{3, 1}, got.List())
+	})
+
+	t.Run("scan postgres output", func(t *testing.T) {
+		got := NewSet[string]()
+
+		require.NoError(t, got.Scan([]byte(`{plain, "with space",b}`)))
+		assert.Equal(t, []string{"plain", "with space", "b"}, got.List())
+
+		require.NoError(t, got.Scan(`{}`))
+		assert.True(t, got.IsEmpty())
+	})
+
+	t.Run("scan json", func(t *testing.T) {
+		got := NewSet[int]()
+
+		require.NoError(t, got.Scan(`[1, 2, 1]`))
+		assert.Equal(t, []int{1, 2}, got.List())
+	})
+
+	t.Run("json round trip", func(t *testing.T) {
+		db := openFakeDB(t)
+
+		stored := storeFakeCell(t, db, NewSet[string]("b", "a").JSON())
+		assert.Equal(t, []byte(`["b","a"]`), stored)
+
+		got := NewSet[string]()
+		loadFakeCell(t, db, got)
+
+		assert.Equal(t, []string{"b", "a"}, got.List())
+
+		assert.Nil(t, storeFakeCell(t, db, (*Set[string])(nil).JSON()))
+	})
+
+	t.Run("scan null elements", func(t *testing.T) {
+		got := NewSet[int]()
+
+		require.NoError(t, got.Scan(`{1,NULL,2}`))
+		assert.Equal(t, []int{1, 0, 2}, got.List())
+	})
+
+	t.Run("null", func(t *testing.T) {
+		db := openFakeDB(t)
+
+		stored := storeFakeCell(t, db, (*Set[int])(nil))
+		assert.Nil(t, stored)
+
+		got := NewSet[int](1)
+		loadFakeCell(t, db, got)
+
+		assert.True(t, got.IsEmpty())
+	})
+}
+
+func TestStrings_SQL(t *testing.T) {
+	t.Run("round trip", func(t *testing.T) {
+		db := openFakeDB(t)
+
+		strs := NewStrings("a", "b", "a", `{x}`)
+
+		stored := storeFakeCell(t, db, strs)
+		assert.Equal(t, `{"a","b","a","{x}"}`, stored)
+
+		got := NewStrings()
+		loadFakeCell(t, db, got)
+
+		assert.Equal(t, strs.List(), got.List())
+	})
+
+	t.Run("json round trip", func(t *testing.T) {
+		db := openFakeDB(t)
+
+		stored := storeFakeCell(t, db, NewStrings("a", "b", "a").JSON())
+		assert.Equal(t, []byte(`["a","b","a"]`), stored)
+
+		got := NewStrings()
+		loadFakeCell(t, db, got)
+
+		assert.Equal(t, []string{"a", "b", "a"}, got.List())
+
+		assert.Nil(t, storeFakeCell(t, db, (*Strings)(nil).JSON()))
+	})
+
+	t.Run("scan null elements", func(t *testing.T) {
+		got := NewStrings()
+
+		require.NoError(t, got.Scan(`{a,NULL,"NULL"}`))
+		assert.Equal(t, []string{"a", "", "NULL"}, got.List())
+	})
+
+	t.Run("null", func(t *testing.T) {
+		db := openFakeDB(t)
+
+		storeFakeCell(t, db, nil)
+
+		got := NewStrings("old")
+		loadFakeCell(t, db, got)
+
+		assert.True(t, got.IsEmpty())
+	})
+}
+
+func TestString_SQL(t *testing.T) {
+	db := openFakeDB(t)
+
+	storeFakeCell(t, db, "value")
+
+	got := NewEmptyString()
+	loadFakeCell(t, db, got)
+
+	assert.Equal(t, "value", got.Value)
+}
+
+func TestParsePgArray(t *testing.T) {
+	cases := []struct {
+		Title       string
+		Src         string
+		Expected    []pgArrayElement
+		ExpectedErr string
+	}{
+		{
+			Title:    "empty",
+			Src:      "{}",
+			Expected: []pgArrayElement{},
+		},
+		{
+			Title:    "unquoted",
+			Src:      "{a,b,c}",
+			Expected: []pgArrayElement{{value: "a"}, {value: "b"}, {value: "c"}},
+		},
+		{
+			Title:    "quoted with escapes",
+			Src:      `{"a \"b\"","c\\d",e}`,
+			Expected: []pgArrayElement{{value: `a "b"`}, {value: `c\d`}, {value: "e"}},
+		},
+		{
+			Title:    "empty quoted element",
+			Src:      `{"",a}`,
+			Expected: []pgArrayElement{{value: ""}, {value: "a"}},
+		},
+		{
+			Title:    "null element",
+			Src:      `{a,NULL,null,"NULL"}`,
+			Expected: []pgArrayElement{{value: "a"}, {null: true}, {null: true}, {value: "NULL"}},
+		},
+		{
+			Title:       "multidimensional",
+			Src:         `{{a},{b}}`,
+			ExpectedErr: "only one-dimensional arrays are supported",
+		},
+		{
+			Title:       "no braces",
+			Src:         `a,b`,
+			ExpectedErr: "array literal must be enclosed in braces",
+		},
+		{
+			Title:       "unterminated quote",
+			Src:         `{"a}`,
+			ExpectedErr: "unterminated quoted element",
+		},
+		{
+			Title:       "empty element",
+			Src:         `{a,,b}`,
+			ExpectedErr: "empty element",
+		},
+	}
+
+	for _, tCase := range cases {
+		t.Run(tCase.Title, func(t *testing.T) {
+			got, err := parsePgArray(tCase.Src)
+			if tCase.ExpectedErr != "" {
+				require.ErrorContains(t, err, tCase.ExpectedErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tCase.Expected, got)
+		})
+	}
+}
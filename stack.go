@@ -0,0 +1,96 @@
+package gds
+
+import (
+	"iter"
+	"slices"
+)
+
+// Stack is a LIFO collection. The zero value is an empty stack ready to use.
+type Stack[T any] struct {
+	items []T
+
+	nilVal T
+}
+
+var _ Collection[int] = (*Stack[int])(nil)
+
+// NewStack creates a stack with values pushed in the given order, so the last value is on top.
+func NewStack[T any](values ...T) *Stack[T] {
+	return &Stack[T]{
+		items: slices.Clone(values),
+	}
+}
+
+func (s *Stack[T]) Push(values ...T) {
+	s.items = append(s.items, values...)
+}
+
+func (s *Stack[T]) Pop() (T, bool) {
+	if len(s.items) == 0 {
+		return s.nilVal, false
+	}
+
+	last := len(s.items) - 1
+	val := s.items[last]
+
+	s.items[last] = s.nilVal
+	s.items = s.items[:last]
+
+	return val, true
+}
+
+func (s *Stack[T]) Peek() (T, bool) {
+	if len(s.items) == 0 {
+		return s.nilVal, false
+	}
+
+	return s.items[len(s.items)-1], true
+}
+
+// First returns the top item.
+func (s *Stack[T]) First() T {
+	val, _ := s.Peek()
+
+	return val
+}
+
+func (s *Stack[T]) Len() int {
+	return len(s.items)
+}
+
+func (s *Stack[T]) IsEmpty() bool {
+	return len(s.items) == 0
+}
+
+func (s *Stack[T]) IsNotEmpty() bool {
+	return len(s.items) > 0
+}
+
+// List returns items from the top to the bottom.
+func (s *Stack[T]) List() []T {
+	list := make([]T, len(s.items))
+	copy(list, s.items)
+	slices.Reverse(list)
+
+	return list
+}
+
+// All returns an iterator over items from the top to the bottom.
+func (s *Stack[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := len(s.items) - 1; i >= 0; i-- {
+			if i >= len(s.items) {
+				continue
+			}
+
+			if !yield(s.items[i]) {
+				return
+			}
+		}
+	}
+}
+
+func (s *Stack[T]) Clear() {
+	clear(s.items)
+	s.items = s.items[:0]
+}
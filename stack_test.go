@@ -0,0 +1,73 @@
+package gds
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStack(t *testing.T) {
+	s := NewStack[string]("a", "b")
+
+	s.Push("c", "d")
+
+	assert.Equal(t, 4, s.Len())
+	assert.Equal(t, "d", s.First())
+	assert.Equal(t, []string{"d", "c", "b", "a"}, s.List())
+	assert.Equal(t, []string{"d", "c", "b", "a"}, slices.Collect(s.All()))
+
+	top, ok := s.Peek()
+	require.True(t, ok)
+	assert.Equal(t, "d", top)
+
+	for _, expected := range []string{"d", "c", "b", "a"} {
+		val, popped := s.Pop()
+		require.True(t, popped)
+		assert.Equal(t, expected, val)
+	}
+
+	_, ok = s.Pop()
+	assert.False(t, ok)
+
+	_, ok = s.Peek()
+	assert.False(t, ok)
+	assert.True(t, s.IsEmpty())
+}
+
+func TestStack_ZeroValue(t *testing.T) {
+	var s Stack[int]
+
+	assert.Equal(t, 0, s.First())
+	assert.Equal(t, []int{}, s.List())
+
+	s.Push(1)
+	s.Clear()
+	s.Push(2)
+
+	assert.Equal(t, []int{2}, s.List())
+	assert.True(t, s.IsNotEmpty())
+}
+
+func TestStack_DepthFirstTraversal(t *testing.T) {
+	tree := map[string][]string{
+		"root": {"a", "b"},
+		"a":    {"a1", "a2"},
+		"b":    {"b1"},
+	}
+
+	visited := []string{}
+	stack := NewStack[string]("root")
+
+	for stack.IsNotEmpty() {
+		node, _ := stack.Pop()
+		visited = append(visited, node)
+
+		children := slices.Clone(tree[node])
+		slices.Reverse(children)
+		stack.Push(children...)
+	}
+
+	assert.Equal(t, []string{"root", "a", "a1", "a2", "b", "b1"}, visited)
+}
@@ -1,7 +1,10 @@
 package gds
 
 import (
+	"database/sql/driver"
+	"encoding/json"
 	"fmt"
+	"iter"
 	"slices"
 	"strings"
 )
@@ -16,6 +19,12 @@ func NewStrings(items ...string) *Strings {
 	}
 }
 
+func NewStringsFromSeq(seq iter.Seq[string]) *Strings {
+	return &Strings{
+		items: slices.Collect(seq),
+	}
+}
+
 func (s *Strings) Add(str string) {
 	s.items = append(s.items, str)
 }
@@ -53,6 +62,20 @@ func (s *Strings) List() []string {
 	return s.items
 }
 
+func (s *Strings) All() iter.Seq[string] {
+	return slices.Values(s.items)
+}
+
+func (s *Strings) Backward() iter.Seq[string] {
+	return func(yield func(string) bool) {
+		for _, str := range slices.Backward(s.items) {
+			if !yield(str) {
+				return
+			}
+		}
+	}
+}
+
 func (s *Strings) Wrap(wrapper string) *Strings {
 	strs := &Strings{
 		items: make([]string, len(s.items)),
@@ -64,3 +87,53 @@ func (s *Strings) Wrap(wrapper string) *Strings {
 
 	return strs
 }
+
+func (s *Strings) MarshalJSON() ([]byte, error) {
+	if s.items == nil {
+		return []byte("[]"), nil
+	}
+
+	return json.Marshal(s.items)
+}
+
+func (s *Strings) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &s.items)
+}
+
+// Scan reads strings from a Postgres array literal, e.g. a text[] column, or from a JSON array.
+// NULL gives empty strings, NULL elements of the array give empty strings too.
+func (s *Strings) Scan(src any) error {
+	if src == nil {
+		s.items = nil
+
+		return nil
+	}
+
+	items, err := scanSQLList[string](src)
+	if err != nil {
+		return err
+	}
+
+	s.items = items
+
+	return nil
+}
+
+// Value writes strings as a Postgres array literal, nil Strings is written as NULL.
+func (s *Strings) Value() (driver.Value, error) {
+	if s == nil {
+		return nil, nil //nolint:nilnil // NULL value
+	}
+
+	return valueSQLList(s.items)
+}
+
+// JSON returns a driver.Valuer which writes strings as a JSON array, e.g. to a jsonb column.
+// Nil Strings is written as NULL.
+func (s *Strings) JSON() driver.Valuer {
+	if s == nil {
+		return sqlNull{}
+	}
+
+	return sqlJSON{value: s}
+}
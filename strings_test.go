@@ -1,9 +1,12 @@
 package gds
 
 import (
+	"encoding/json"
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestStrings_Wrap(t *testing.T) {
@@ -11,3 +14,81 @@ func TestStrings_Wrap(t *testing.T) {
 
 	assert.Equal(t, []string{"'a'", "'b'", "'c'"}, strs.Wrap("'").items)
 }
+
+func TestStrings_Iterators(t *testing.T) {
+	strs := NewStrings("a", "b", "c")
+
+	assert.Equal(t, []string{"a", "b", "c"}, slices.Collect(strs.All()))
+	assert.Equal(t, []string{"c", "b", "a"}, slices.Collect(strs.Backward()))
+}
+
+func TestNewStringsFromSeq(t *testing.T) {
+	strs := NewStringsFromSeq(slices.Values([]string{"a", "b"}))
+
+	assert.Equal(t, []string{"a", "b"}, strs.List())
+}
+
+func TestStrings_JSON(t *testing.T) {
+	data, err := json.Marshal(NewStrings("b", "a"))
+	require.NoError(t, err)
+	assert.Equal(t, `["b","a"]`, string(data))
+
+	data, err = json.Marshal(NewStrings())
+	require.NoError(t, err)
+	assert.Equal(t, `[]`, string(data))
+
+	got := NewStrings()
+	require.NoError(t, json.Unmarshal([]byte(`["x","y"]`), got))
+	assert.Equal(t, []string{"x", "y"}, got.List())
+}
+
+func TestStrings_ZeroValue(t *testing.T) {
+	t.Run("read methods", func(t *testing.T) {
+		var s Strings
+
+		assert.Equal(t, "", s.First())
+		assert.Equal(t, 0, s.Len())
+		assert.True(t, s.IsEmpty())
+		assert.False(t, s.IsNotEmpty())
+		assert.False(t, s.Contains("a"))
+		assert.Empty(t, s.List())
+		assert.Empty(t, slices.Collect(s.All()))
+		assert.Empty(t, slices.Collect(s.Backward()))
+		assert.Equal(t, "", s.Join(",").Value)
+		assert.Empty(t, s.Wrap("'").List())
+	})
+
+	t.Run("add", func(t *testing.T) {
+		var s Strings
+
+		s.Add("a")
+
+		assert.Equal(t, []string{"a"}, s.List())
+	})
+
+	t.Run("marshal", func(t *testing.T) {
+		var s Strings
+
+		data, err := json.Marshal(&s)
+		require.NoError(t, err)
+		assert.Equal(t, `[]`, string(data))
+
+		value, err := s.Value()
+		require.NoError(t, err)
+		assert.Equal(t, `{}`, value)
+	})
+
+	t.Run("unmarshal", func(t *testing.T) {
+		var s Strings
+
+		require.NoError(t, json.Unmarshal([]byte(`["a"]`), &s))
+		assert.Equal(t, []string{"a"}, s.List())
+	})
+
+	t.Run("scan", func(t *testing.T) {
+		var s Strings
+
+		require.NoError(t, s.Scan(`{a,b}`))
+		assert.Equal(t, []string{"a", "b"}, s.List())
+	})
+}
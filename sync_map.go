@@ -0,0 +1,165 @@
+package gds
+
+import (
+	"iter"
+	"reflect"
+	"sync"
+)
+
+// SyncMap is a Map safe for concurrent use.
+// Methods returning slices or iterators work on a snapshot taken under the lock.
+type SyncMap[K comparable, V any] struct {
+	mu sync.RWMutex
+	m  Map[K, V]
+}
+
+func NewSyncMap[K comparable, V any]() *SyncMap[K, V] {
+	return &SyncMap[K, V]{
+		m: *NewMap[K, V](),
+	}
+}
+
+func NewSyncMapFrom[K comparable, V any](m *Map[K, V]) *SyncMap[K, V] {
+	return &SyncMap[K, V]{
+		m: *m.Clone(),
+	}
+}
+
+func (s *SyncMap[K, V]) First() V {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.m.First()
+}
+
+func (s *SyncMap[K, V]) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.m.Len()
+}
+
+func (s *SyncMap[K, V]) IsEmpty() bool {
+	return s.Len() == 0
+}
+
+func (s *SyncMap[K, V]) IsNotEmpty() bool {
+	return s.Len() > 0
+}
+
+func (s *SyncMap[K, V]) Has(key K) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.m.Has(key)
+}
+
+func (s *SyncMap[K, V]) Get(key K) (V, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.m.Get(key)
+}
+
+func (s *SyncMap[K, V]) Set(key K, val V) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.m.Set(key, val)
+}
+
+func (s *SyncMap[K, V]) Delete(key K) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.m.Delete(key)
+}
+
+func (s *SyncMap[K, V]) DeleteMany(keys []K) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.m.DeleteMany(keys)
+}
+
+// GetOrSet returns the existing value for the key if present.
+// Otherwise, it sets and returns the given value. The loaded result is true if the value was loaded.
+func (s *SyncMap[K, V]) GetOrSet(key K, val V) (actual V, loaded bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if existing, has := s.m.Get(key); has {
+		return existing, true
+	}
+
+	s.m.Set(key, val)
+
+	return val, false
+}
+
+// CompareAndSwap sets newVal for the key if the current value is deeply equal to oldVal.
+func (s *SyncMap[K, V]) CompareAndSwap(key K, oldVal, newVal V) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	current, has := s.m.Get(key)
+	if !has || !reflect.DeepEqual(current, oldVal) {
+		return false
+	}
+
+	s.m.Set(key, newVal)
+
+	return true
+}
+
+// Update sets the value returned by updater and returns it.
+// The updater gets the current value and whether the key is present, and runs under the lock,
+// so it must not call methods of the same SyncMap.
+func (s *SyncMap[K, V]) Update(key K, updater func(val V, exists bool) V) V {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	current, has := s.m.Get(key)
+	newVal := updater(current, has)
+
+	s.m.Set(key, newVal)
+
+	return newVal
+}
+
+func (s *SyncMap[K, V]) List() []V {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.m.List()
+}
+
+func (s *SyncMap[K, V]) Keys() []K {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.m.Keys()
+}
+
+func (s *SyncMap[K, V]) ToMap() map[K]V {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.m.ToMap()
+}
+
+// Snapshot returns a copy of the underlying Map.
+func (s *SyncMap[K, V]) Snapshot() *Map[K, V] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.m.Clone()
+}
+
+func (s *SyncMap[K, V]) All() iter.Seq2[K, V] {
+	return s.Snapshot().All()
+}
+
+func (s *SyncMap[K, V]) Walk(callback func(key K, val V) bool) {
+	s.Snapshot().Walk(callback)
+}
@@ -0,0 +1,115 @@
+package gds
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyncMap_GetOrSet(t *testing.T) {
+	m := NewSyncMap[string, int]()
+
+	got, loaded := m.GetOrSet("a", 1)
+	assert.False(t, loaded)
+	assert.Equal(t, 1, got)
+
+	got, loaded = m.GetOrSet("a", 2)
+	assert.True(t, loaded)
+	assert.Equal(t, 1, got)
+}
+
+func TestSyncMap_CompareAndSwap(t *testing.T) {
+	m := NewSyncMap[string, []string]()
+	m.Set("a", []string{"1"})
+
+	assert.False(t, m.CompareAndSwap("a", []string{"2"}, []string{"3"}))
+	assert.False(t, m.CompareAndSwap("b", nil, []string{"3"}))
+	assert.True(t, m.CompareAndSwap("a", []string{"1"}, []string{"3"}))
+
+	got, _ := m.Get("a")
+	assert.Equal(t, []string{"3"}, got)
+}
+
+func TestSyncMap_Snapshots(t *testing.T) {
+	m := NewSyncMap[string, int]()
+	m.Set("b", 1)
+	m.Set("a", 2)
+
+	keys := m.Keys()
+	list := m.List()
+	snapshot := m.Snapshot()
+
+	m.Set("c", 3)
+	m.Delete("b")
+
+	assert.Equal(t, []string{"b", "a"}, keys)
+	assert.Equal(t, []int{1, 2}, list)
+	assert.Equal(t, []string{"b", "a"}, snapshot.Keys())
+	assert.Equal(t, []string{"a", "c"}, m.Keys())
+}
+
+func TestSyncMap_Walk(t *testing.T) {
+	m := NewSyncMap[string, int]()
+	m.Set("b", 1)
+	m.Set("a", 2)
+
+	keys := []string{}
+
+	m.Walk(func(key string, val int) bool {
+		keys = append(keys, key)
+		m.Set(key+key, val)
+		return true
+	})
+
+	assert.Equal(t, []string{"b", "a"}, keys)
+	assert.Equal(t, []string{"b", "a", "bb", "aa"}, m.Keys())
+}
+
+func TestSyncMap_Concurrent(t *testing.T) {
+	const (
+		workers = 16
+		ops     = 500
+	)
+
+	m := NewSyncMap[string, int]()
+	wg := sync.WaitGroup{}
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+
+		go func(w int) {
+			defer wg.Done()
+
+			for i := 0; i < ops; i++ {
+				key := strconv.Itoa(i % 50)
+
+				m.Update("counter", func(val int, _ bool) int {
+					return val + 1
+				})
+				m.GetOrSet(key, w)
+				m.Set(strconv.Itoa(w)+"-"+key, i)
+				m.Get(key)
+				m.Keys()
+				m.List()
+
+				for range m.All() {
+					break
+				}
+
+				if i%10 == 0 {
+					m.Delete(strconv.Itoa(w) + "-" + key)
+				}
+			}
+		}(w)
+	}
+
+	wg.Wait()
+
+	counter, ok := m.Get("counter")
+	require.True(t, ok)
+	assert.Equal(t, workers*ops, counter)
+	assert.Equal(t, len(m.Keys()), m.Len())
+}
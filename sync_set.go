@@ -0,0 +1,106 @@
+package gds
+
+import (
+	"iter"
+	"sync"
+)
+
+// SyncSet is a Set safe for concurrent use.
+// Methods returning slices or iterators work on a snapshot taken under the lock.
+type SyncSet[T comparable] struct {
+	mu  sync.RWMutex
+	set Set[T]
+}
+
+func NewSyncSet[T comparable](values ...T) *SyncSet[T] {
+	return &SyncSet[T]{
+		set: *NewSet(values...),
+	}
+}
+
+func (s *SyncSet[T]) First() T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.set.First()
+}
+
+func (s *SyncSet[T]) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.set.Len()
+}
+
+func (s *SyncSet[T]) IsEmpty() bool {
+	return s.Len() == 0
+}
+
+func (s *SyncSet[T]) IsNotEmpty() bool {
+	return s.Len() > 0
+}
+
+func (s *SyncSet[T]) Has(value T) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.set.Has(value)
+}
+
+// Add adds the item and reports whether it was absent.
+func (s *SyncSet[T]) Add(val T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.set.Has(val) {
+		return false
+	}
+
+	s.set.Add(val)
+
+	return true
+}
+
+func (s *SyncSet[T]) Remove(val T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.set.Remove(val)
+}
+
+func (s *SyncSet[T]) Pop() (T, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.set.Pop()
+}
+
+func (s *SyncSet[T]) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.set.Clear()
+}
+
+func (s *SyncSet[T]) List() []T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.set.List()
+}
+
+// Snapshot returns a copy of the underlying Set.
+func (s *SyncSet[T]) Snapshot() *Set[T] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.set.Clone()
+}
+
+func (s *SyncSet[T]) All() iter.Seq[T] {
+	return s.Snapshot().All()
+}
+
+func (s *SyncSet[T]) Walk(callback func(item T) bool) {
+	s.Snapshot().Walk(callback)
+}
@@ -0,0 +1,77 @@
+package gds
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSyncSet_Add(t *testing.T) {
+	set := NewSyncSet[int](1)
+
+	assert.False(t, set.Add(1))
+	assert.True(t, set.Add(2))
+	assert.Equal(t, []int{1, 2}, set.List())
+}
+
+func TestSyncSet_Concurrent(t *testing.T) {
+	const (
+		workers = 16
+		items   = 1000
+	)
+
+	set := NewSyncSet[int]()
+	added := atomic.Int64{}
+	wg := sync.WaitGroup{}
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for i := 0; i < items; i++ {
+				if set.Add(i) {
+					added.Add(1)
+				}
+
+				set.Has(i)
+				set.List()
+
+				for range set.All() {
+					break
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	assert.Equal(t, int64(items), added.Load())
+	assert.Equal(t, items, set.Len())
+
+	removed := atomic.Int64{}
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for {
+				if _, ok := set.Pop(); !ok {
+					return
+				}
+
+				removed.Add(1)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	assert.Equal(t, int64(items), removed.Load())
+	assert.True(t, set.IsEmpty())
+}
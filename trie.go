@@ -0,0 +1,296 @@
+package gds
+
+import (
+	"cmp"
+	"iter"
+	"slices"
+	"unicode/utf8"
+)
+
+// TrieOrder defines order of keys returned by Trie.Autocomplete.
+type TrieOrder int
+
+const (
+	// TrieOrderInsertion returns keys in order in which they were added.
+	TrieOrderInsertion TrieOrder = iota
+	// TrieOrderLexical returns keys sorted by runes.
+	TrieOrderLexical
+)
+
+// Trie is a prefix tree keyed by strings. Keys are split by runes, so prefixes never cut a multibyte character,
+// invalid UTF-8 bytes are stored as utf8.RuneError.
+// The zero value is an empty trie ready to use.
+type Trie[V any] struct {
+	root trieNode[V]
+	len  int
+	seq  uint64
+
+	nilVal V
+}
+
+type trieNode[V any] struct {
+	children map[rune]*trieNode[V]
+	value    V
+	has      bool
+	// order is a sequence number of key insertion, it keeps position when the value is replaced.
+	order uint64
+}
+
+type trieItem[V any] struct {
+	key   string
+	value V
+	order uint64
+}
+
+func NewTrie[V any]() *Trie[V] {
+	return &Trie[V]{}
+}
+
+// NewTrieFromSet builds a trie of set items in set order.
+func NewTrieFromSet(set *Set[string]) *Trie[struct{}] {
+	trie := NewTrie[struct{}]()
+
+	for item := range set.All() {
+		trie.Set(item, struct{}{})
+	}
+
+	return trie
+}
+
+// Set adds the key or replaces its value. Replaced key keeps its insertion position.
+func (t *Trie[V]) Set(key string, val V) {
+	node := &t.root
+
+	for _, r := range key {
+		if node.children == nil {
+			node.children = map[rune]*trieNode[V]{}
+		}
+
+		child, ok := node.children[r]
+		if !ok {
+			child = &trieNode[V]{}
+			node.children[r] = child
+		}
+
+		node = child
+	}
+
+	if !node.has {
+		t.seq++
+		t.len++
+
+		node.has = true
+		node.order = t.seq
+	}
+
+	node.value = val
+}
+
+func (t *Trie[V]) Get(key string) (V, bool) {
+	node := t.find(key)
+	if node == nil || !node.has {
+		return t.nilVal, false
+	}
+
+	return node.value, true
+}
+
+func (t *Trie[V]) Has(key string) bool {
+	node := t.find(key)
+
+	return node != nil && node.has
+}
+
+// Delete removes the key and reports whether it was present. Branches left without keys are pruned.
+func (t *Trie[V]) Delete(key string) bool {
+	path := make([]*trieNode[V], 0, utf8.RuneCountInString(key)+1)
+	runes := make([]rune, 0, cap(path)-1)
+
+	node := &t.root
+	path = append(path, node)
+
+	for _, r := range key {
+		node = node.children[r]
+		if node == nil {
+			return false
+		}
+
+		path = append(path, node)
+		runes = append(runes, r)
+	}
+
+	if !node.has {
+		return false
+	}
+
+	node.has = false
+	node.value = t.nilVal
+	node.order = 0
+	t.len--
+
+	for i := len(path) - 1; i > 0; i-- {
+		if path[i].has || len(path[i].children) > 0 {
+			break
+		}
+
+		delete(path[i-1].children, runes[i-1])
+	}
+
+	return true
+}
+
+// HasPrefix reports whether any key starts with prefix.
+func (t *Trie[V]) HasPrefix(prefix string) bool {
+	node := t.find(prefix)
+
+	return node != nil && (node.has || len(node.children) > 0)
+}
+
+// KeysWithPrefix returns keys starting with prefix in insertion order.
+func (t *Trie[V]) KeysWithPrefix(prefix string) *Strings {
+	return t.Autocomplete(prefix, 0, TrieOrderInsertion)
+}
+
+// Autocomplete returns at most limit keys starting with prefix in given order. Limit less than 1 means no limit.
+func (t *Trie[V]) Autocomplete(prefix string, limit int, order TrieOrder) *Strings {
+	items := t.collect(prefix, limit, order)
+
+	keys := make([]string, 0, len(items))
+	for _, item := range items {
+		keys = append(keys, item.key)
+	}
+
+	return NewStrings(keys...)
+}
+
+// LongestPrefixOf returns the longest prefix of s which is a key.
+// Like in Get, an invalid UTF-8 byte of s matches utf8.RuneError of a key, so with key "a\uFFFD"
+// the prefix of "a\xffb" is "a\xff". The returned prefix is always a part of s.
+func (t *Trie[V]) LongestPrefixOf(s string) (string, V, bool) {
+	node := &t.root
+
+	found := node.has
+	end := 0
+	val := node.value
+
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		i += size
+
+		node = node.children[r]
+		if node == nil {
+			break
+		}
+
+		if node.has {
+			found = true
+			end = i
+			val = node.value
+		}
+	}
+
+	if !found {
+		return "", t.nilVal, false
+	}
+
+	return s[:end], val, true
+}
+
+// Keys returns all keys in insertion order.
+func (t *Trie[V]) Keys() *Strings {
+	return t.KeysWithPrefix("")
+}
+
+// All returns an iterator over keys and values in insertion order.
+func (t *Trie[V]) All() iter.Seq2[string, V] {
+	return func(yield func(string, V) bool) {
+		for _, item := range t.collect("", 0, TrieOrderInsertion) {
+			if !yield(item.key, item.value) {
+				return
+			}
+		}
+	}
+}
+
+func (t *Trie[V]) Len() int {
+	return t.len
+}
+
+func (t *Trie[V]) IsEmpty() bool {
+	return t.len == 0
+}
+
+func (t *Trie[V]) IsNotEmpty() bool {
+	return t.len > 0
+}
+
+func (t *Trie[V]) find(prefix string) *trieNode[V] {
+	node := &t.root
+
+	for _, r := range prefix {
+		node = node.children[r]
+		if node == nil {
+			return nil
+		}
+	}
+
+	return node
+}
+
+func (t *Trie[V]) collect(prefix string, limit int, order TrieOrder) []trieItem[V] {
+	node := t.find(prefix)
+	if node == nil {
+		return []trieItem[V]{}
+	}
+
+	items := []trieItem[V]{}
+	key := []byte(prefix)
+
+	if order == TrieOrderLexical {
+		// lexical walk visits keys in final order, so it stops at the limit
+		node.walkLexical(key, func(item trieItem[V]) bool {
+			items = append(items, item)
+
+			return limit < 1 || len(items) < limit
+		})
+
+		return items
+	}
+
+	node.walkLexical(key, func(item trieItem[V]) bool {
+		items = append(items, item)
+
+		return true
+	})
+
+	slices.SortFunc(items, func(a, b trieItem[V]) int {
+		return cmp.Compare(a.order, b.order)
+	})
+
+	if limit > 0 && len(items) > limit {
+		items = items[:limit]
+	}
+
+	return items
+}
+
+func (n *trieNode[V]) walkLexical(key []byte, callback func(item trieItem[V]) bool) bool {
+	if n.has && !callback(trieItem[V]{key: string(key), value: n.value, order: n.order}) {
+		return false
+	}
+
+	runes := make([]rune, 0, len(n.children))
+	for r := range n.children {
+		runes = append(runes, r)
+	}
+
+	slices.Sort(runes)
+
+	for _, r := range runes {
+		if !n.children[r].walkLexical(utf8.AppendRune(key, r), callback) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,139 @@
+package gds
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrie_SetGetDelete(t *testing.T) {
+	trie := NewTrie[int]()
+
+	trie.Set("car", 1)
+	trie.Set("cart", 2)
+	trie.Set("cat", 3)
+	trie.Set("car", 4)
+
+	assert.Equal(t, 3, trie.Len())
+
+	val, ok := trie.Get("car")
+	require.True(t, ok)
+	assert.Equal(t, 4, val)
+
+	_, ok = trie.Get("ca")
+	assert.False(t, ok)
+	assert.False(t, trie.Has("ca"))
+	assert.True(t, trie.Has("cat"))
+
+	assert.False(t, trie.Delete("ca"))
+	assert.False(t, trie.Delete("cars"))
+	assert.True(t, trie.Delete("cart"))
+	assert.False(t, trie.Has("cart"))
+	assert.True(t, trie.Has("car"))
+	assert.False(t, trie.HasPrefix("cart"))
+
+	assert.True(t, trie.Delete("car"))
+	assert.True(t, trie.Delete("cat"))
+	assert.True(t, trie.IsEmpty())
+	assert.False(t, trie.HasPrefix("c"))
+	assert.Empty(t, trie.root.children)
+}
+
+func TestTrie_Prefixes(t *testing.T) {
+	trie := NewTrieFromSet(NewSet("user_id", "users", "user", "order", "ордер", "орда"))
+
+	assert.True(t, trie.HasPrefix("use"))
+	assert.True(t, trie.HasPrefix(""))
+	assert.True(t, trie.HasPrefix("ор"))
+	assert.False(t, trie.HasPrefix("x"))
+
+	assert.Equal(t, []string{"user_id", "users", "user"}, trie.KeysWithPrefix("user").List())
+	assert.Equal(t, []string{"ордер", "орда"}, trie.KeysWithPrefix("ор").List())
+	assert.Equal(t, []string{}, trie.KeysWithPrefix("x").List())
+
+	key, _, ok := trie.LongestPrefixOf("user_identifier")
+	require.True(t, ok)
+	assert.Equal(t, "user_id", key)
+
+	key, _, ok = trie.LongestPrefixOf("user_name")
+	require.True(t, ok)
+	assert.Equal(t, "user", key)
+
+	key, _, ok = trie.LongestPrefixOf("ордерок")
+	require.True(t, ok)
+	assert.Equal(t, "ордер", key)
+
+	_, _, ok = trie.LongestPrefixOf("use")
+	assert.False(t, ok)
+}
+
+func TestTrie_Autocomplete(t *testing.T) {
+	trie := NewTrie[string]()
+
+	trie.Set("select", "SELECT")
+	trie.Set("set", "SET")
+	trie.Set("schema", "SCHEMA")
+	trie.Set("session", "SESSION")
+
+	assert.Equal(t, []string{"select", "set", "session"}, trie.Autocomplete("se", 0, TrieOrderInsertion).List())
+	assert.Equal(t, []string{"select", "session", "set"}, trie.Autocomplete("se", 0, TrieOrderLexical).List())
+	assert.Equal(t, []string{"select", "set"}, trie.Autocomplete("se", 2, TrieOrderInsertion).List())
+	assert.Equal(t, []string{"schema", "select"}, trie.Autocomplete("s", 2, TrieOrderLexical).List())
+
+	assert.Equal(t, []string{"select", "set", "schema", "session"}, trie.Keys().List())
+
+	values := []string{}
+	for key, val := range trie.All() {
+		values = append(values, key+"="+val)
+	}
+
+	assert.Equal(t, []string{"select=SELECT", "set=SET", "schema=SCHEMA", "session=SESSION"}, values)
+}
+
+func TestTrie_ZeroValue(t *testing.T) {
+	var trie Trie[int]
+
+	assert.True(t, trie.IsEmpty())
+	assert.False(t, trie.HasPrefix(""))
+	assert.Equal(t, []string{}, trie.Keys().List())
+
+	trie.Set("", 1)
+	trie.Set("a", 2)
+
+	assert.True(t, trie.IsNotEmpty())
+
+	key, val, ok := trie.LongestPrefixOf("b")
+	require.True(t, ok)
+	assert.Equal(t, "", key)
+	assert.Equal(t, 1, val)
+}
+
+func TestTrie_InvalidUTF8(t *testing.T) {
+	trie := NewTrie[int]()
+
+	trie.Set("\xff", 1)
+	trie.Set("a�", 2)
+
+	key, val, ok := trie.LongestPrefixOf("\xff")
+	require.True(t, ok)
+	assert.Equal(t, "\xff", key)
+	assert.Equal(t, 1, val)
+
+	key, val, ok = trie.LongestPrefixOf("a\xffbc")
+	require.True(t, ok)
+	assert.Equal(t, "a\xff", key)
+	assert.Equal(t, 2, val)
+
+	key, _, ok = trie.LongestPrefixOf("a�bc")
+	require.True(t, ok)
+	assert.Equal(t, "a�", key)
+
+	key, _, ok = trie.LongestPrefixOf("a\xfe\xff")
+	require.True(t, ok)
+	assert.Equal(t, "a\xfe", key)
+
+	val, ok = trie.Get("a\xff")
+	require.True(t, ok)
+	assert.Equal(t, 2, val)
+}
@@ -0,0 +1,241 @@
+package gds
+
+import (
+	"sync"
+	"time"
+)
+
+type Clock interface {
+	Now() time.Time
+}
+
+// TickerClock is a Clock which also provides ticks for TTLMap.StartJanitor, e.g. a fake clock in tests.
+// Ticks must be delivered like time.Ticker does: dropped when the channel is full.
+type TickerClock interface {
+	Clock
+	NewTicker(interval time.Duration) (ticks <-chan time.Time, stop func())
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (systemClock) NewTicker(interval time.Duration) (<-chan time.Time, func()) {
+	ticker := time.NewTicker(interval)
+
+	return ticker.C, ticker.Stop
+}
+
+// TTLMap is a Map safe for concurrent use, where entries expire after TTL.
+// Expired entries are removed lazily on access, by DeleteExpired or by the janitor goroutine.
+type TTLMap[K comparable, V any] struct {
+	mu      sync.Mutex
+	entries Map[K, ttlEntry[V]]
+
+	defaultTTL time.Duration
+	clock      Clock
+	onExpire   []func(key K, val V)
+}
+
+type ttlEntry[V any] struct {
+	value     V
+	expiresAt time.Time
+}
+
+// NewTTLMap creates a map with TTL for entries added by Set. Entries don't expire when TTL is not positive.
+// Clock can be nil, then system time is used.
+func NewTTLMap[K comparable, V any](defaultTTL time.Duration, clock Clock) *TTLMap[K, V] {
+	if clock == nil {
+		clock = systemClock{}
+	}
+
+	return &TTLMap[K, V]{
+		entries:    *NewMap[K, ttlEntry[V]](),
+		defaultTTL: defaultTTL,
+		clock:      clock,
+	}
+}
+
+// OnExpire registers a callback which is called for every removed expired entry.
+func (m *TTLMap[K, V]) OnExpire(callback func(key K, val V)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.onExpire = append(m.onExpire, callback)
+}
+
+func (m *TTLMap[K, V]) Set(key K, val V) {
+	m.SetWithTTL(key, val, m.defaultTTL)
+}
+
+// SetWithTTL sets the entry which expires after ttl. The entry doesn't expire when ttl is not positive.
+func (m *TTLMap[K, V]) SetWithTTL(key K, val V, ttl time.Duration) {
+	entry := ttlEntry[V]{
+		value: val,
+	}
+
+	if ttl > 0 {
+		entry.expiresAt = m.clock.Now().Add(ttl)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.entries.Set(key, entry)
+}
+
+func (m *TTLMap[K, V]) Get(key K) (V, bool) {
+	m.mu.Lock()
+
+	entry, has := m.entries.Get(key)
+	if !has {
+		m.mu.Unlock()
+
+		return entry.value, false
+	}
+
+	if !m.expired(entry, m.clock.Now()) {
+		m.mu.Unlock()
+
+		return entry.value, true
+	}
+
+	m.entries.Delete(key)
+	callbacks := m.onExpire
+
+	m.mu.Unlock()
+
+	for _, callback := range callbacks {
+		callback(key, entry.value)
+	}
+
+	var nilVal V
+
+	return nilVal, false
+}
+
+func (m *TTLMap[K, V]) Has(key K) bool {
+	_, has := m.Get(key)
+
+	return has
+}
+
+// TTL returns the remaining time to live of the entry.
+// It returns zero duration for entries which don't expire.
+func (m *TTLMap[K, V]) TTL(key K) (time.Duration, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	entry, has := m.entries.Get(key)
+	if !has {
+		return 0, false
+	}
+
+	now := m.clock.Now()
+	if m.expired(entry, now) {
+		return 0, false
+	}
+
+	if entry.expiresAt.IsZero() {
+		return 0, true
+	}
+
+	return entry.expiresAt.Sub(now), true
+}
+
+func (m *TTLMap[K, V]) Delete(key K) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.entries.Delete(key)
+}
+
+// Len returns count of entries which are not expired.
+func (m *TTLMap[K, V]) Len() int {
+	return len(m.Keys())
+}
+
+// Keys returns keys of entries which are not expired, in insertion order.
+func (m *TTLMap[K, V]) Keys() []K {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.clock.Now()
+	keys := make([]K, 0, m.entries.Len())
+
+	for k, entry := range m.entries.All() {
+		if !m.expired(entry, now) {
+			keys = append(keys, k)
+		}
+	}
+
+	return keys
+}
+
+// DeleteExpired removes expired entries and returns their count.
+func (m *TTLMap[K, V]) DeleteExpired() int {
+	m.mu.Lock()
+
+	now := m.clock.Now()
+	expired := make([]Entry[K, V], 0)
+
+	for k, entry := range m.entries.All() {
+		if m.expired(entry, now) {
+			expired = append(expired, Entry[K, V]{Key: k, Value: entry.value})
+		}
+	}
+
+	for _, entry := range expired {
+		m.entries.Delete(entry.Key)
+	}
+
+	callbacks := m.onExpire
+
+	m.mu.Unlock()
+
+	for _, entry := range expired {
+		for _, callback := range callbacks {
+			callback(entry.Key, entry.Value)
+		}
+	}
+
+	return len(expired)
+}
+
+// StartJanitor runs a goroutine which calls DeleteExpired every interval, until stop is called.
+// Ticks are taken from the clock when it implements TickerClock, otherwise time.Ticker is used.
+func (m *TTLMap[K, V]) StartJanitor(interval time.Duration) (stop func()) {
+	clock, ok := m.clock.(TickerClock)
+	if !ok {
+		clock = systemClock{}
+	}
+
+	ticks, stopTicker := clock.NewTicker(interval)
+	done := make(chan struct{})
+	once := sync.Once{}
+
+	go func() {
+		defer stopTicker()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticks:
+				m.DeleteExpired()
+			}
+		}
+	}()
+
+	return func() {
+		once.Do(func() {
+			close(done)
+		})
+	}
+}
+
+func (m *TTLMap[K, V]) expired(entry ttlEntry[V], now time.Time) bool {
+	return !entry.expiresAt.IsZero() && !now.Before(entry.expiresAt)
+}
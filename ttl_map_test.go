@@ -0,0 +1,230 @@
+package gds
+
+import (
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeClock struct {
+	mu      sync.Mutex
+	now     time.Time
+	tickers []*fakeTicker
+}
+
+type fakeTicker struct {
+	interval time.Duration
+	next     time.Time
+	ticks    chan time.Time
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{
+		now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+// Advance moves time forward and ticks every ticker whose interval has passed.
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+
+	for _, ticker := range c.tickers {
+		if c.now.Before(ticker.next) {
+			continue
+		}
+
+		select {
+		case ticker.ticks <- c.now:
+		default:
+		}
+
+		ticker.next = c.now.Add(ticker.interval)
+	}
+}
+
+func (c *fakeClock) NewTicker(interval time.Duration) (<-chan time.Time, func()) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ticker := &fakeTicker{
+		interval: interval,
+		next:     c.now.Add(interval),
+		ticks:    make(chan time.Time, 1),
+	}
+
+	c.tickers = append(c.tickers, ticker)
+
+	return ticker.ticks, func() {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+
+		c.tickers = slices.DeleteFunc(c.tickers, func(t *fakeTicker) bool {
+			return t == ticker
+		})
+	}
+}
+
+func TestTTLMap_Get(t *testing.T) {
+	clock := newFakeClock()
+	m := NewTTLMap[string, int](time.Minute, clock)
+
+	expired := []string{}
+	m.OnExpire(func(key string, _ int) {
+		expired = append(expired, key)
+	})
+
+	m.Set("a", 1)
+	m.SetWithTTL("b", 2, 2*time.Minute)
+	m.SetWithTTL("c", 3, 0)
+
+	clock.Advance(59 * time.Second)
+
+	got, ok := m.Get("a")
+	require.True(t, ok)
+	assert.Equal(t, 1, got)
+
+	clock.Advance(time.Second)
+
+	_, ok = m.Get("a")
+	assert.False(t, ok)
+	assert.True(t, m.Has("b"))
+	assert.Equal(t, []string{"a"}, expired)
+
+	clock.Advance(time.Hour)
+
+	assert.False(t, m.Has("b"))
+	assert.True(t, m.Has("c"))
+	assert.Equal(t, []string{"a", "b"}, expired)
+}
+
+func TestTTLMap_Set(t *testing.T) {
+	clock := newFakeClock()
+	m := NewTTLMap[string, int](time.Minute, clock)
+
+	m.Set("a", 1)
+	m.Set("b", 2)
+
+	clock.Advance(30 * time.Second)
+	m.Set("a", 3)
+	clock.Advance(45 * time.Second)
+
+	got, ok := m.Get("a")
+	require.True(t, ok)
+	assert.Equal(t, 3, got)
+	assert.Equal(t, []string{"a"}, m.Keys())
+}
+
+func TestTTLMap_TTL(t *testing.T) {
+	clock := newFakeClock()
+	m := NewTTLMap[string, int](time.Minute, clock)
+
+	m.Set("a", 1)
+	m.SetWithTTL("b", 2, -1)
+
+	clock.Advance(20 * time.Second)
+
+	ttl, ok := m.TTL("a")
+	require.True(t, ok)
+	assert.Equal(t, 40*time.Second, ttl)
+
+	ttl, ok = m.TTL("b")
+	require.True(t, ok)
+	assert.Equal(t, time.Duration(0), ttl)
+
+	_, ok = m.TTL("c")
+	assert.False(t, ok)
+}
+
+func TestTTLMap_DeleteExpired(t *testing.T) {
+	clock := newFakeClock()
+	m := NewTTLMap[string, int](time.Minute, clock)
+
+	expired := NewMap[string, int]()
+	m.OnExpire(func(key string, val int) {
+		expired.Set(key, val)
+	})
+
+	m.Set("a", 1)
+	m.SetWithTTL("b", 2, time.Hour)
+	m.Set("c", 3)
+	m.Set("d", 4)
+	m.Delete("d")
+
+	assert.Equal(t, 3, m.Len())
+
+	clock.Advance(time.Minute)
+
+	assert.Equal(t, 1, m.Len())
+	assert.Equal(t, 2, m.DeleteExpired())
+	assert.Equal(t, 0, m.DeleteExpired())
+
+	assert.Equal(t, []string{"a", "c"}, expired.Keys())
+	assert.Equal(t, []int{1, 3}, expired.List())
+	assert.Equal(t, []string{"b"}, m.Keys())
+}
+
+func TestTTLMap_Janitor(t *testing.T) {
+	clock := newFakeClock()
+	m := NewTTLMap[string, int](time.Minute, clock)
+
+	expired := make(chan string, 1)
+	m.OnExpire(func(key string, _ int) {
+		expired <- key
+	})
+
+	m.Set("a", 1)
+	m.Set("b", 2)
+
+	stop := m.StartJanitor(time.Second)
+
+	clock.Advance(time.Minute)
+	assert.Equal(t, "a", <-expired)
+	assert.Equal(t, "b", <-expired)
+
+	stop()
+	stop()
+}
+
+func TestTTLMap_Concurrent(t *testing.T) {
+	clock := newFakeClock()
+	m := NewTTLMap[int, int](time.Second, clock)
+
+	stop := m.StartJanitor(time.Millisecond)
+	defer stop()
+
+	wg := sync.WaitGroup{}
+
+	for w := 0; w < 8; w++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for i := 0; i < 500; i++ {
+				m.Set(i%20, i)
+				m.Get(i % 20)
+				m.Keys()
+
+				if i%50 == 0 {
+					clock.Advance(time.Second)
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+}